@@ -0,0 +1,12 @@
+data "meroxa_connector_logs" "basic" {
+  connector  = meroxa_connector.basic.name
+  tail_lines = 100
+  level      = "ERROR"
+}
+
+check "connector_healthy" {
+  assert {
+    condition     = length(data.meroxa_connector_logs.basic.lines) == 0
+    error_message = "Connector logged errors:\n${data.meroxa_connector_logs.basic.logs}"
+  }
+}
@@ -0,0 +1,7 @@
+data "meroxa_connectors" "orders_sources" {
+  pipeline_name = "orders"
+  type          = "source"
+  metadata = {
+    "mx:connectorType" = "source"
+  }
+}
@@ -0,0 +1,3 @@
+data "meroxa_endpoint" "orders" {
+  name = "orders"
+}
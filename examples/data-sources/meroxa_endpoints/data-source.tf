@@ -0,0 +1,4 @@
+data "meroxa_endpoints" "ready_http" {
+  protocol = "HTTP"
+  ready    = true
+}
@@ -0,0 +1,3 @@
+data "meroxa_environment" "shared" {
+  name = "shared"
+}
@@ -0,0 +1,5 @@
+data "meroxa_environments" "dedicated" {
+  region = "us-east-1"
+  type   = "dedicated"
+  state  = "provisioned"
+}
@@ -0,0 +1,4 @@
+data "meroxa_pipelines" "orders" {
+  name_regex = "^orders-"
+  state      = "healthy"
+}
@@ -0,0 +1,7 @@
+data "meroxa_resources" "postgres" {
+  type   = "postgres"
+  status = "ready"
+  metadata = {
+    team = "data-platform"
+  }
+}
@@ -0,0 +1,16 @@
+data "meroxa_user" "current" {
+  lifecycle {
+    postcondition {
+      condition     = self.email == "platform@example.com"
+      error_message = "This workspace must be applied with the platform account."
+    }
+  }
+}
+
+resource "meroxa_environment" "dedicated" {
+  count = contains(data.meroxa_user.current.features, "environments") ? 1 : 0
+
+  name   = "dedicated"
+  type   = "dedicated"
+  region = "us-east-1"
+}
@@ -4,6 +4,16 @@ provider "meroxa" {
   api_url      = var.api_url      # optionally use MEROXA_API_URL env var
   timeout      = var.timeout      # optionally use MEROXA_TIMEOUT env var
 
+  # Wait between two polls while waiting for objects to settle
+  poll_interval = "10s" # optionally use MEROXA_POLL_INTERVAL env var
+
+  # Metadata added to every resource and connector
+  default_metadata {
+    metadata = {
+      owner = "data-platform"
+    }
+  }
+
   # To enable debug
   debug = false # optionally use MEROXA_DEBUG env var
 }
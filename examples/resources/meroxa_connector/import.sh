@@ -1,2 +1,5 @@
 # import using the Connector ID from the API
 terraform import meroxa_connector.basic 1
+
+# import using the Pipeline and Connector names
+terraform import meroxa_connector.basic pipeline-name/connector-name
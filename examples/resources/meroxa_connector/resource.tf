@@ -13,4 +13,38 @@ resource "meroxa_connector" "basic" {
   source_id   = meroxa_resource.inline.id
   input       = "public.Users"
   pipeline_id = meroxa_pipeline.basic.id
+
+  metadata = {
+    owner   = "data-team"
+    runbook = "https://wiki.example.com/runbooks/users-source"
+  }
+}
+
+resource "meroxa_connector" "with_secrets" {
+  name        = "with-secrets"
+  source_id   = meroxa_resource.inline.id
+  input       = "public.Orders"
+  pipeline_id = meroxa_pipeline.basic.id
+
+  config = {
+    "snapshot" = "true"
+  }
+
+  # Hidden from plans, only a hash of each value is kept in the state
+  sensitive_config = {
+    "password" = var.connector_password
+  }
+}
+
+resource "meroxa_connector" "typed" {
+  name        = "typed"
+  source_id   = meroxa_resource.inline.id
+  input       = "public.Customers"
+  pipeline_id = meroxa_pipeline.basic.id
+
+  # Validated at plan time and translated into config
+  postgres_source {
+    slot_name          = "customers"
+    table_include_list = ["public.Customers"]
+  }
 }
@@ -0,0 +1,2 @@
+# import using the Endpoint name from the API
+terraform import meroxa_endpoint.http http
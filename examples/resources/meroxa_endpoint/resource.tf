@@ -0,0 +1,10 @@
+resource "meroxa_endpoint" "http" {
+  name     = "http"
+  protocol = "HTTP"
+  stream   = meroxa_connector.basic.streams[0].output[0]
+}
+
+output "endpoint_url" {
+  value     = meroxa_endpoint.http.url
+  sensitive = true
+}
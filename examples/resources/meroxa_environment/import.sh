@@ -0,0 +1,2 @@
+# import using the Environment name or UUID from the API
+terraform import meroxa_environment.dedicated dedicated
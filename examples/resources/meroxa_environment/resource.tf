@@ -0,0 +1,10 @@
+resource "meroxa_environment" "dedicated" {
+  name   = "dedicated"
+  type   = "dedicated"
+  region = "us-east-1"
+
+  config = {
+    aws_access_key_id     = var.aws_access_key_id
+    aws_secret_access_key = var.aws_secret_access_key
+  }
+}
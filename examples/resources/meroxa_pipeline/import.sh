@@ -1,2 +1,5 @@
 # import using the Pipeline ID from the API
 terraform import meroxa_pipeline.basic 1
+
+# import using the Pipeline name
+terraform import meroxa_pipeline.basic pipeline-name
@@ -1,3 +1,4 @@
 resource "meroxa_pipeline" "basic" {
-  name = "pipeline"
+  name          = "pipeline"
+  desired_state = "running"
 }
@@ -1,2 +1,5 @@
 # import using the Resource ID from the API
 terraform import meroxa_resource.inline 1
+
+# import using the Resource name
+terraform import meroxa_resource.inline resource-name
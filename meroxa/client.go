@@ -0,0 +1,157 @@
+package meroxa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+const (
+	defaultPollInterval = 30 * time.Second
+	// maxPollInterval is the largest interval the SDK state waiters accept.
+	maxPollInterval = 180 * time.Second
+)
+
+// providerClient is the meta value handed to resources and data sources. It
+// embeds the Meroxa API client, so `m.(meroxa.Client)` keeps working, and
+// carries the provider-level settings.
+type providerClient struct {
+	meroxa.Client
+
+	// pollInterval is the wait between two refreshes of a state waiter.
+	pollInterval time.Duration
+	// pollBackoff switches state waiters to the SDK exponential backoff.
+	pollBackoff bool
+	// defaultMetadata is added to the metadata of resources and connectors.
+	defaultMetadata map[string]interface{}
+
+	// resourceTypes caches the supported resource types once the API listed
+	// them, a failed listing is tried again on the next plan.
+	resourceTypesMu sync.Mutex
+	resourceTypes   []string
+}
+
+// defaultMetadata returns the provider default metadata, if any.
+func defaultMetadata(c meroxa.Client) map[string]interface{} {
+	if pc, ok := c.(*providerClient); ok {
+		return pc.defaultMetadata
+	}
+	return nil
+}
+
+// supportedResourceTypes returns the resource types supported by the platform.
+// listed is false when the API could not list them and the types known to the
+// provider are returned instead.
+func supportedResourceTypes(ctx context.Context, c meroxa.Client) (types []string, listed bool) {
+	pc, ok := c.(*providerClient)
+	if !ok {
+		return listResourceTypes(ctx, c)
+	}
+
+	pc.resourceTypesMu.Lock()
+	defer pc.resourceTypesMu.Unlock()
+	if pc.resourceTypes != nil {
+		return pc.resourceTypes, true
+	}
+	types, listed = listResourceTypes(ctx, pc.Client)
+	if listed {
+		pc.resourceTypes = types
+	}
+	return types, listed
+}
+
+// listResourceTypes lists the resource types, falling back to the types known
+// to the client when the API cannot be reached.
+func listResourceTypes(ctx context.Context, c meroxa.Client) ([]string, bool) {
+	types, err := c.ListResourceTypes(ctx)
+	if err == nil && len(types) > 0 {
+		return types, true
+	}
+
+	tflog.Warn(ctx, "Listing Meroxa resource types failed, using the types known to the provider", map[string]interface{}{
+		"error": fmt.Sprint(err),
+	})
+	return []string{
+		string(meroxa.ResourceTypePostgres),
+		string(meroxa.ResourceTypeMysql),
+		string(meroxa.ResourceTypeRedshift),
+		string(meroxa.ResourceTypeUrl),
+		string(meroxa.ResourceTypeS3),
+		string(meroxa.ResourceTypeMongodb),
+		string(meroxa.ResourceTypeElasticsearch),
+		string(meroxa.ResourceTypeSnowflake),
+		string(meroxa.ResourceTypeBigquery),
+		string(meroxa.ResourceTypeSqlserver),
+		string(meroxa.ResourceTypeCosmosdb),
+	}, false
+}
+
+// waitForState applies the provider poll settings to conf and waits for it
+// to reach its target state.
+func waitForState(ctx context.Context, c meroxa.Client, conf *resource.StateChangeConf) (interface{}, error) {
+	interval, backoff := defaultPollInterval, false
+	if pc, ok := c.(*providerClient); ok {
+		interval, backoff = pc.pollInterval, pc.pollBackoff
+	}
+
+	if backoff {
+		// The SDK starts at 100ms and doubles the wait up to 10 seconds
+		conf.Delay = 0
+		conf.MinTimeout = 0
+	} else {
+		conf.Delay = interval
+		conf.PollInterval = interval
+	}
+
+	return conf.WaitForStateContext(ctx)
+}
+
+// createConnector creates a connector like the client CreateConnector, which
+// overwrites the input metadata with the connector type, but keeps the input
+// metadata.
+func createConnector(ctx context.Context, c meroxa.Client, input *meroxa.CreateConnectorInput) (*meroxa.Connector, error) {
+	if input.Configuration == nil {
+		input.Configuration = make(map[string]interface{})
+	}
+	input.Configuration["input"] = input.Input
+
+	metadata := make(map[string]interface{}, len(input.Metadata)+1)
+	for k, v := range input.Metadata {
+		metadata[k] = v
+	}
+	metadata["mx:connectorType"] = string(input.Type)
+	input.Metadata = metadata
+
+	resp, err := c.MakeRequest(ctx, http.MethodPost, "/v1/connectors", input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var conn meroxa.Connector
+	if err := decodeResponse(resp, &conn); err != nil {
+		return nil, err
+	}
+	return &conn, nil
+}
+
+// decodeResponse decodes the body of an API response into v, or the API
+// error it carries.
+func decodeResponse(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode > http.StatusNoContent {
+		var e apiError
+		if err := json.NewDecoder(resp.Body).Decode(&e); err != nil || e.Message == "" {
+			return fmt.Errorf("%s %s", resp.Proto, resp.Status)
+		}
+		return &e
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,202 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func connectionDetailsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Description: "Resource connection details the URL is built from, as an alternative to `url`. " +
+			"The provider percent-encodes every part.",
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"url", "connection_details"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scheme": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "URL scheme. Defaults to the scheme of the resource type, e.g. `postgres`.",
+				},
+				"host": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Host name or address. Required unless the resource is `s3` or `snowflake`.",
+				},
+				"port": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "Port, the default port of the scheme when unset",
+					ValidateDiagFunc: validatePositiveInt("port"),
+				},
+				"database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Database name",
+				},
+				"username": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "Username, sent as the resource credentials rather than in the URL",
+					ConflictsWith: []string{"credentials"},
+				},
+				"password": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "Password, sent as the resource credentials rather than in the URL",
+					ConflictsWith: []string{"credentials"},
+				},
+				"params": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: "Query parameters, e.g. `sslmode = \"require\"`",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "S3 bucket region",
+				},
+				"bucket": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "S3 bucket name",
+				},
+				"account": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Snowflake account URL, e.g. `xy12345.us-east-1.snowflakecomputing.com`",
+				},
+				"warehouse": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Snowflake warehouse",
+				},
+				"schema": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Snowflake schema",
+				},
+			},
+		},
+	}
+}
+
+// resourceResourceURL returns the configured url, or the one built from the
+// connection_details block.
+func resourceResourceURL(d *schema.ResourceData) string {
+	if l := d.Get("connection_details").([]interface{}); len(l) > 0 && l[0] != nil {
+		return buildResourceURL(meroxa.ResourceType(d.Get("type").(string)), l[0].(map[string]interface{}))
+	}
+	return d.Get("url").(string)
+}
+
+// connectionDetailsCredentials returns the username and password of the
+// connection_details block as resource credentials, nil if there are none.
+func connectionDetailsCredentials(d *schema.ResourceData) *meroxa.Credentials {
+	l := d.Get("connection_details").([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	c := l[0].(map[string]interface{})
+
+	username, password := c["username"].(string), c["password"].(string)
+	if username == "" && password == "" {
+		return nil
+	}
+	return &meroxa.Credentials{Username: username, Password: password}
+}
+
+// buildResourceURL assembles a resource URL from its connection details and
+// percent-encodes its parts. Credentials are left out of the URL: the client
+// encodes inline credentials once more, and the URL is kept in the state.
+func buildResourceURL(resourceType meroxa.ResourceType, c map[string]interface{}) string {
+	u := &url.URL{Scheme: c["scheme"].(string)}
+	if rule, ok := resourceURLRules[resourceType]; ok && u.Scheme == "" {
+		u.Scheme = rule.schemes[0]
+	}
+
+	switch resourceType {
+	case meroxa.ResourceTypeS3:
+		u.Host = c["region"].(string)
+		u.Path = "/" + c["bucket"].(string)
+	case meroxa.ResourceTypeSnowflake:
+		u.Host = c["account"].(string)
+		u.Path = "/" + strings.Join(nonEmpty(c["database"].(string), c["schema"].(string)), "/")
+	default:
+		u.Host = c["host"].(string)
+		if db := c["database"].(string); db != "" {
+			u.Path = "/" + db
+		}
+	}
+	if port := c["port"].(int); port > 0 {
+		u.Host = net.JoinHostPort(u.Host, strconv.Itoa(port))
+	}
+
+	q := url.Values{}
+	for k, v := range c["params"].(map[string]interface{}) {
+		q.Set(k, v.(string))
+	}
+	if warehouse := c["warehouse"].(string); warehouse != "" && resourceType == meroxa.ResourceTypeSnowflake {
+		q.Set("warehouse", warehouse)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// customizeDiffConnectionDetails checks the connection_details block holds
+// the parts the resource type needs, so a bad block fails at plan time.
+func customizeDiffConnectionDetails(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	l := d.Get("connection_details").([]interface{})
+	if len(l) == 0 || l[0] == nil || !d.NewValueKnown("connection_details") || !d.NewValueKnown("type") {
+		return nil
+	}
+	c := l[0].(map[string]interface{})
+
+	var required []string
+	switch resourceType := meroxa.ResourceType(d.Get("type").(string)); resourceType {
+	case meroxa.ResourceTypeS3:
+		required = []string{"region", "bucket"}
+	case meroxa.ResourceTypeSnowflake:
+		required = []string{"account", "database"}
+	default:
+		required = []string{"host"}
+		if _, ok := resourceURLRules[resourceType]; !ok && c["scheme"].(string) == "" {
+			required = append(required, "scheme")
+		}
+	}
+
+	var missing []string
+	for _, k := range required {
+		if c[k].(string) == "" {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("connection_details of %s resources require: %s", d.Get("type"), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func nonEmpty(s ...string) []string {
+	r := make([]string, 0, len(s))
+	for _, v := range s {
+		if v != "" {
+			r = append(r, v)
+		}
+	}
+	return r
+}
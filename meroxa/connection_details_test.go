@@ -0,0 +1,134 @@
+package meroxa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestBuildResourceURL(t *testing.T) {
+	details := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"scheme": "", "host": "", "port": 0, "database": "", "username": "", "password": "",
+			"params": map[string]interface{}{}, "region": "", "bucket": "", "account": "", "warehouse": "", "schema": "",
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name         string
+		resourceType meroxa.ResourceType
+		details      map[string]interface{}
+		want         string
+	}{
+		{
+			name:         "postgres",
+			resourceType: meroxa.ResourceTypePostgres,
+			details: details(map[string]interface{}{
+				"host":     "pg.example.com",
+				"port":     5432,
+				"database": "orders",
+				"username": "app",
+				"password": "p@ss:w/rd",
+				"params":   map[string]interface{}{"sslmode": "require"},
+			}),
+			want: "postgres://pg.example.com:5432/orders?sslmode=require",
+		},
+		{
+			name:         "scheme override",
+			resourceType: meroxa.ResourceTypeMongodb,
+			details:      details(map[string]interface{}{"scheme": "mongodb+srv", "host": "cluster0.example.net"}),
+			want:         "mongodb+srv://cluster0.example.net",
+		},
+		{
+			name:         "s3",
+			resourceType: meroxa.ResourceTypeS3,
+			details:      details(map[string]interface{}{"region": "us-east-1", "bucket": "orders"}),
+			want:         "s3://us-east-1/orders",
+		},
+		{
+			name:         "snowflake",
+			resourceType: meroxa.ResourceTypeSnowflake,
+			details: details(map[string]interface{}{
+				"account":   "xy12345.us-east-1.snowflakecomputing.com",
+				"database":  "analytics",
+				"schema":    "public",
+				"warehouse": "loading",
+			}),
+			want: "snowflakedb://xy12345.us-east-1.snowflakecomputing.com/analytics/public?warehouse=loading",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildResourceURL(tt.resourceType, tt.details); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails_conflictsWithURL(t *testing.T) {
+	diags := resourceResource().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "pg",
+		"type":               "postgres",
+		"url":                "postgres://pg.example.com:5432/db",
+		"connection_details": []interface{}{map[string]interface{}{"host": "pg.example.com"}},
+	}))
+	if !diags.HasError() {
+		t.Error("expected an error setting both url and connection_details")
+	}
+}
+
+func TestMeroxaResource_connectionDetails(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	r := resourceResource()
+
+	d := testResourceData(t, r, map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"connection_details": []interface{}{
+			map[string]interface{}{
+				"host":     "pg.example.com",
+				"port":     5432,
+				"database": "orders",
+				"username": "app",
+				"password": "p@ss%w",
+				"params":   map[string]interface{}{"sslmode": "require"},
+			},
+		},
+	})
+	requireNoError(t, r.CreateContext(context.Background(), d, meta))
+
+	// Credentials are sent as is, not encoded in the URL
+	if creds := api.findResource("pg").Credentials; creds == nil || creds.Username != "app" || creds.Password != "p@ss%w" {
+		t.Errorf("got API credentials %+v, want app and the raw password", creds)
+	}
+
+	want := "postgres://pg.example.com:5432/orders?sslmode=require"
+	if got := api.findResource("pg").URL; got != want {
+		t.Errorf("got API URL %q, want %q", got, want)
+	}
+	if got := d.Get("url"); got != want {
+		t.Errorf("got url %q, want %q", got, want)
+	}
+}
+
+func TestConnectionDetails_missingParts(t *testing.T) {
+	api := newFakeAPI(t)
+
+	_, err := resourceResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "orders",
+		"type":               "s3",
+		"connection_details": []interface{}{map[string]interface{}{"region": "us-east-1"}},
+	}), api.meta())
+	if err == nil || err.Error() != "connection_details of s3 resources require: bucket" {
+		t.Errorf("got %v, want the missing bucket reported", err)
+	}
+}
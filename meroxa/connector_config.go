@@ -0,0 +1,290 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+// connectorConfigBlock is a typed alternative to the raw connector config map
+// for one resource type. Each attribute of the block maps to a config key.
+type connectorConfigBlock struct {
+	resourceType  meroxa.ResourceType
+	connectorType meroxa.ConnectorType
+	// keys maps the block attributes to the connector config keys.
+	keys   map[string]string
+	schema map[string]*schema.Schema
+}
+
+// connectorConfigBlocks are the typed config blocks of the connector resource,
+// by block name.
+var connectorConfigBlocks = map[string]connectorConfigBlock{
+	"postgres_source": {
+		resourceType:  meroxa.ResourceTypePostgres,
+		connectorType: meroxa.ConnectorTypeSource,
+		keys: map[string]string{
+			"slot_name":          "slot.name",
+			"publication_name":   "publication.name",
+			"snapshot_mode":      "snapshot.mode",
+			"table_include_list": "table.include.list",
+		},
+		schema: map[string]*schema.Schema{
+			"slot_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the logical replication slot",
+			},
+			"publication_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the publication streamed from",
+			},
+			"snapshot_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "initial",
+				Description: "When to snapshot the tables. Must be one of `initial`, `never` or `initial_only`.",
+				ValidateDiagFunc: validateStringInSlice("snapshot mode", []string{
+					"initial",
+					"never",
+					"initial_only",
+				}),
+			},
+			"table_include_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tables to capture, e.g. `public.orders`",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+	"s3_destination": {
+		resourceType:  meroxa.ResourceTypeS3,
+		connectorType: meroxa.ConnectorTypeDestination,
+		keys: map[string]string{
+			"prefix":             "topics.dir",
+			"format":             "format",
+			"flush_size":         "flush.size",
+			"rotate_interval_ms": "rotate.interval.ms",
+		},
+		schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix of the objects written to the bucket",
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "json",
+				Description:      "Format of the objects. Must be one of `json`, `avro` or `parquet`.",
+				ValidateDiagFunc: validateStringInSlice("format", []string{"json", "avro", "parquet"}),
+			},
+			"flush_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				Description:      "Number of records written to each object",
+				ValidateDiagFunc: validatePositiveInt("flush size"),
+			},
+			"rotate_interval_ms": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Longest time in milliseconds an object stays open before it is written",
+				ValidateDiagFunc: validatePositiveInt("rotate interval"),
+			},
+		},
+	},
+	"snowflake_destination": {
+		resourceType:  meroxa.ResourceTypeSnowflake,
+		connectorType: meroxa.ConnectorTypeDestination,
+		keys: map[string]string{
+			"database":             "snowflake.database.name",
+			"schema":               "snowflake.schema.name",
+			"topic_to_table_map":   "snowflake.topic2table.map",
+			"buffer_count_records": "buffer.count.records",
+			"buffer_flush_time":    "buffer.flush.time",
+		},
+		schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Database the records are loaded into",
+			},
+			"schema": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Schema the records are loaded into",
+			},
+			"topic_to_table_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Table each stream is loaded into, by stream name",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"buffer_count_records": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          10000,
+				Description:      "Number of records buffered before they are loaded",
+				ValidateDiagFunc: validatePositiveInt("buffer count records"),
+			},
+			"buffer_flush_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          120,
+				Description:      "Longest time in seconds records stay buffered before they are loaded",
+				ValidateDiagFunc: validatePositiveInt("buffer flush time"),
+			},
+		},
+	},
+}
+
+// connectorConfigBlockSchemas returns the schema of the typed config blocks.
+// Source blocks conflict with destination_id and destination blocks with
+// source_id, and only one block may be set.
+func connectorConfigBlockSchemas() map[string]*schema.Schema {
+	names := connectorConfigBlockNames()
+
+	schemas := make(map[string]*schema.Schema, len(connectorConfigBlocks))
+	for name, block := range connectorConfigBlocks {
+		conflicts := []string{"source_id"}
+		if block.connectorType == meroxa.ConnectorTypeSource {
+			conflicts = []string{"destination_id"}
+		}
+		for _, other := range names {
+			if other != name {
+				conflicts = append(conflicts, other)
+			}
+		}
+
+		schemas[name] = &schema.Schema{
+			Type: schema.TypeList,
+			Description: fmt.Sprintf("Typed configuration of a %s %s connector, translated into `config`. "+
+				"Keys set in `config` take precedence.", block.resourceType, block.connectorType),
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: conflicts,
+			Elem:          &schema.Resource{Schema: block.schema},
+		}
+	}
+	return schemas
+}
+
+// connectorConfigBlockNames returns the names of the typed config blocks.
+func connectorConfigBlockNames() []string {
+	names := make([]string, 0, len(connectorConfigBlocks))
+	for name := range connectorConfigBlocks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkConnectorConfigBlockResource checks the typed config block set, if
+// any, is meant for the type of the resource the connector reads from or
+// writes to.
+func checkConnectorConfigBlockResource(ctx context.Context, c meroxa.Client, d *schema.ResourceData, resourceID int) diag.Diagnostics {
+	for _, name := range connectorConfigBlockNames() {
+		if l := d.Get(name).([]interface{}); len(l) == 0 || l[0] == nil {
+			continue
+		}
+
+		res, err := c.GetResourceByNameOrID(ctx, strconv.Itoa(resourceID))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		block := connectorConfigBlocks[name]
+		if res.Type == block.resourceType {
+			return nil
+		}
+
+		attr := "source_id"
+		if block.connectorType == meroxa.ConnectorTypeDestination {
+			attr = "destination_id"
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Connector config block does not match the resource type",
+			Detail: fmt.Sprintf("%s configures %s resources, the resource %q of %s is a %s resource",
+				name, block.resourceType, res.Name, attr, res.Type),
+			AttributePath: cty.GetAttrPath(name),
+		}}
+	}
+	return nil
+}
+
+// expandConnectorConfigBlocks translates the typed config block set, if any,
+// into connector config keys.
+func expandConnectorConfigBlocks(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	for name, block := range connectorConfigBlocks {
+		l := d.Get(name).([]interface{})
+		if len(l) == 0 || l[0] == nil {
+			continue
+		}
+
+		for attr, v := range l[0].(map[string]interface{}) {
+			if s := connectorConfigValue(v); s != "" {
+				config[block.keys[attr]] = s
+			}
+		}
+	}
+	return config
+}
+
+// connectorConfigValue formats a typed block value the way the connector
+// config expects it. Empty values are left out.
+func connectorConfigValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int:
+		if v == 0 {
+			return ""
+		}
+		return strconv.Itoa(v)
+	case []interface{}:
+		s := make([]string, 0, len(v))
+		for _, e := range v {
+			s = append(s, e.(string))
+		}
+		return strings.Join(s, ",")
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(v))
+		for _, k := range keys {
+			pairs = append(pairs, fmt.Sprintf("%s:%s", k, v[k]))
+		}
+		return strings.Join(pairs, ",")
+	}
+	return ""
+}
+
+func validatePositiveInt(name string) schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if val.(int) <= 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid %s", name),
+				Detail:        fmt.Sprintf("%s should be a positive number", name),
+				AttributePath: path,
+			})
+		}
+		return diags
+	}
+}
@@ -0,0 +1,118 @@
+package meroxa
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandConnectorConfigBlocks(t *testing.T) {
+	d := testResourceData(t, resourceConnector(), map[string]interface{}{
+		"name":        "orders-sink",
+		"input":       "orders",
+		"pipeline_id": 1,
+		"snowflake_destination": []interface{}{
+			map[string]interface{}{
+				"database":           "analytics",
+				"schema":             "public",
+				"topic_to_table_map": map[string]interface{}{"orders": "ORDERS", "customers": "CUSTOMERS"},
+			},
+		},
+		"config": map[string]interface{}{"buffer.flush.time": "30"},
+	})
+
+	want := map[string]interface{}{
+		"snowflake.database.name":   "analytics",
+		"snowflake.schema.name":     "public",
+		"snowflake.topic2table.map": "customers:CUSTOMERS,orders:ORDERS",
+		"buffer.count.records":      "10000",
+		"buffer.flush.time":         "30",
+	}
+	if got := resourceConnectorConfig(d); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestConnectorConfigBlocks_validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		block   string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{name: "valid", block: "s3_destination", raw: map[string]interface{}{"prefix": "orders/", "flush_size": 10}},
+		{name: "unknown key", block: "s3_destination", raw: map[string]interface{}{"flush_sise": 10}, wantErr: true},
+		{name: "invalid value", block: "s3_destination", raw: map[string]interface{}{"format": "csv"}, wantErr: true},
+		{name: "missing required key", block: "snowflake_destination", raw: map[string]interface{}{"database": "analytics"}, wantErr: true},
+		{name: "wrong connector type", block: "postgres_source", raw: map[string]interface{}{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := resourceConnector().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":           "orders",
+				"input":          "public.orders",
+				"pipeline_id":    1,
+				"destination_id": "2",
+				tt.block:         []interface{}{tt.raw},
+			}))
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("got errors %v, want errors %t", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMeroxaConnector_typedConfig(t *testing.T) {
+	api, meta, d := testConnectorData(t, nil, map[string]interface{}{
+		"postgres_source": []interface{}{
+			map[string]interface{}{
+				"slot_name":          "orders",
+				"table_include_list": []interface{}{"public.orders", "public.customers"},
+			},
+		},
+	})
+	requireNoError(t, resourceConnector().CreateContext(context.Background(), d, meta))
+
+	want := map[string]interface{}{
+		"input":              "public.orders",
+		"slot.name":          "orders",
+		"snapshot.mode":      "initial",
+		"table.include.list": "public.orders,public.customers",
+	}
+	if got := api.findConnector("orders-source").Configuration; !reflect.DeepEqual(got, want) {
+		t.Errorf("got API config %v, want %v", got, want)
+	}
+}
+
+func TestMeroxaConnector_typedConfigResourceType(t *testing.T) {
+	api, meta, d := testConnectorData(t, nil, map[string]interface{}{
+		"postgres_source": []interface{}{
+			map[string]interface{}{"slot_name": "orders"},
+		},
+	})
+	ctx := context.Background()
+
+	mysql := testResourceData(t, resourceResource(), map[string]interface{}{
+		"name": "mysql",
+		"type": "mysql",
+		"url":  "mysql://mysql.example.com:3306/db",
+	})
+	requireNoError(t, resourceResource().CreateContext(ctx, mysql, meta))
+	if err := d.Set("source_id", mysql.Id()); err != nil {
+		t.Fatal(err)
+	}
+
+	diags := resourceConnector().CreateContext(ctx, d, meta)
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("postgres_source")) ||
+		!strings.Contains(diags[0].Detail, `the resource "mysql" of source_id is a mysql resource`) {
+		t.Fatalf("got %v, want an error on postgres_source", diags)
+	}
+	if api.findConnector("orders-source") != nil {
+		t.Error("got the connector created, want it rejected")
+	}
+}
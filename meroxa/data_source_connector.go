@@ -63,6 +63,12 @@ func dataSourceConnector() *schema.Resource {
 				Computed:    true,
 				Elem:        schema.TypeString,
 			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Description: "Connector metadata",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"pipeline_id": {
 				Type:        schema.TypeInt,
 				Description: "Connector's Pipeline ID",
@@ -107,6 +113,7 @@ func dataSourceConnectorRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("type", string(conn.Type))
 	_ = d.Set("name", conn.Name)
 	_ = d.Set("config", conn.Configuration)
+	_ = d.Set("metadata", flattenStringMap(conn.Metadata))
 	err = d.Set("streams", flattenStreams(conn))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error setting streams: %s", err))
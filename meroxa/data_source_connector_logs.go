@@ -0,0 +1,145 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+var connectorLogLevels = []string{
+	"TRACE",
+	"DEBUG",
+	"INFO",
+	"WARN",
+	"ERROR",
+	"FATAL",
+}
+
+func dataSourceConnectorLogs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Recent logs of a connector. Lines are first limited to `tail_lines`, then filtered.",
+		ReadContext: dataSourceConnectorLogsRead,
+		Schema: map[string]*schema.Schema{
+			"connector": {
+				Type:        schema.TypeString,
+				Description: "Connector name or ID",
+				Required:    true,
+			},
+			"tail_lines": {
+				Type:        schema.TypeInt,
+				Description: "Only keep the last N lines of the logs. Keeps all lines when unset.",
+				Optional:    true,
+			},
+			"filter_regex": {
+				Type:             schema.TypeString,
+				Description:      "Only keep lines matching this regular expression",
+				Optional:         true,
+				ValidateDiagFunc: validateRegexp(),
+			},
+			"level": {
+				Type:             schema.TypeString,
+				Description:      "Only keep lines logged at this level. Must be one of `TRACE`, `DEBUG`, `INFO`, `WARN`, `ERROR` or `FATAL`.",
+				Optional:         true,
+				ValidateDiagFunc: validateStringInSlice("log level", connectorLogLevels),
+			},
+			"logs": {
+				Type:        schema.TypeString,
+				Description: "Connector logs",
+				Computed:    true,
+			},
+			"lines": {
+				Type:        schema.TypeList,
+				Description: "Connector logs split by line",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceConnectorLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	connector := d.Get("connector").(string)
+	logs, err := getConnectorLogs(ctx, c, connector)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lines := strings.Split(strings.TrimRight(logs, "\n"), "\n")
+	if logs == "" {
+		lines = nil
+	}
+
+	if n := d.Get("tail_lines").(int); n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	var filters []*regexp.Regexp
+	if v := d.Get("filter_regex").(string); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filters = append(filters, re)
+	}
+	if v := d.Get("level").(string); v != "" {
+		filters = append(filters, regexp.MustCompile(`\b`+v+`\b`))
+	}
+
+	filtered := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if matchesAll(l, filters) {
+			filtered = append(filtered, l)
+		}
+	}
+
+	_ = d.Set("logs", strings.Join(filtered, "\n"))
+	if err = d.Set("lines", filtered); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(connector)
+	return diags
+}
+
+// getConnectorLogs reads the plain text log stream of a connector. The client
+// hands back the raw response, so API errors are checked here.
+func getConnectorLogs(ctx context.Context, c meroxa.Client, nameOrID string) (string, error) {
+	resp, err := c.GetConnectorLogs(ctx, nameOrID)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode > http.StatusNoContent {
+		return "", fmt.Errorf("error reading logs of connector (%s): %s %s", nameOrID, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return string(body), nil
+}
+
+func matchesAll(s string, filters []*regexp.Regexp) bool {
+	for _, re := range filters {
+		if !re.MatchString(s) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package meroxa
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestDataMeroxaConnectorLogs(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+
+	api.mu.Lock()
+	for _, name := range []string{"orders-source", "quiet-source"} {
+		conn := &meroxa.Connector{ID: api.id(), Name: name, State: meroxa.ConnectorStateRunning}
+		api.connectors[conn.ID] = conn
+	}
+	api.logs["orders-source"] = "2022-08-01 INFO starting task\n" +
+		"2022-08-01 WARN slot orders lagging\n" +
+		"2022-08-01 INFO snapshot done\n" +
+		"2022-08-01 ERROR connection reset\n"
+	api.mu.Unlock()
+
+	tests := []struct {
+		desc string
+		raw  map[string]interface{}
+		want []interface{}
+	}{
+		{
+			desc: "all lines",
+			raw:  map[string]interface{}{},
+			want: []interface{}{
+				"2022-08-01 INFO starting task",
+				"2022-08-01 WARN slot orders lagging",
+				"2022-08-01 INFO snapshot done",
+				"2022-08-01 ERROR connection reset",
+			},
+		},
+		{
+			desc: "tail",
+			raw:  map[string]interface{}{"tail_lines": 2},
+			want: []interface{}{
+				"2022-08-01 INFO snapshot done",
+				"2022-08-01 ERROR connection reset",
+			},
+		},
+		{
+			desc: "regex filter",
+			raw:  map[string]interface{}{"filter_regex": "orders|reset$"},
+			want: []interface{}{
+				"2022-08-01 WARN slot orders lagging",
+				"2022-08-01 ERROR connection reset",
+			},
+		},
+		{
+			desc: "level",
+			raw:  map[string]interface{}{"level": "INFO"},
+			want: []interface{}{
+				"2022-08-01 INFO starting task",
+				"2022-08-01 INFO snapshot done",
+			},
+		},
+		{
+			// The tail is taken before filtering
+			desc: "tail then level",
+			raw:  map[string]interface{}{"tail_lines": 2, "level": "INFO"},
+			want: []interface{}{"2022-08-01 INFO snapshot done"},
+		},
+		{
+			desc: "no match",
+			raw:  map[string]interface{}{"level": "FATAL"},
+			want: []interface{}{},
+		},
+		{
+			desc: "no logs",
+			raw:  map[string]interface{}{"connector": "quiet-source"},
+			want: []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			raw := map[string]interface{}{"connector": "orders-source"}
+			for k, v := range tt.raw {
+				raw[k] = v
+			}
+			d := testResourceData(t, dataSourceConnectorLogs(), raw)
+			requireNoError(t, dataSourceConnectorLogsRead(context.Background(), d, meta))
+
+			if got := d.Get("lines").([]interface{}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got lines %q, want %q", got, tt.want)
+			}
+			if d.Id() != raw["connector"] {
+				t.Errorf("got ID %q, want %q", d.Id(), raw["connector"])
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package meroxa
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceConnectors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectorsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "Only return connectors whose name matches this regular expression",
+				Optional:         true,
+				ValidateDiagFunc: validateRegexp(),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Only return connectors of this type",
+				Optional:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Only return connectors in this state",
+				Optional:    true,
+			},
+			"pipeline_id": {
+				Type:          schema.TypeInt,
+				Description:   "Only return connectors belonging to the pipeline with this ID",
+				Optional:      true,
+				ConflictsWith: []string{"pipeline_name"},
+			},
+			"pipeline_name": {
+				Type:        schema.TypeString,
+				Description: "Only return connectors belonging to the pipeline with this name",
+				Optional:    true,
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Description: "Only return connectors whose metadata contains all of these key/value pairs",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"connectors": {
+				Type:        schema.TypeList,
+				Description: "List of Connectors",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Connector ID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Connector Name",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Connector Type",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Connector state",
+						},
+						"pipeline_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Connector's Pipeline ID",
+						},
+						"pipeline_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Connector's Pipeline Name",
+						},
+						"metadata": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Connector metadata",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"streams": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Connector Streams",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dynamic": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"input": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"output": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConnectorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	connectors, err := c.ListConnectors(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cType := d.Get("type").(string)
+	state := d.Get("state").(string)
+	pipelineID := d.Get("pipeline_id").(int)
+	pipelineName := d.Get("pipeline_name").(string)
+	metadata := d.Get("metadata").(map[string]interface{})
+
+	filtered := make([]*meroxa.Connector, 0, len(connectors))
+	for _, conn := range connectors {
+		if !nameRegex.MatchString(conn.Name) {
+			continue
+		}
+		if cType != "" && string(conn.Type) != cType {
+			continue
+		}
+		if state != "" && string(conn.State) != state {
+			continue
+		}
+		if pipelineID != 0 && conn.PipelineID != pipelineID {
+			continue
+		}
+		if pipelineName != "" && conn.PipelineName != pipelineName {
+			continue
+		}
+		if !metadataMatches(conn.Metadata, metadata) {
+			continue
+		}
+		filtered = append(filtered, conn)
+	}
+
+	if err = d.Set("connectors", flattenConnectors(filtered)); err != nil {
+		return diag.FromErr(err)
+	}
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func flattenConnectors(connectors []*meroxa.Connector) []interface{} {
+	if connectors != nil {
+		cMap := make([]interface{}, len(connectors))
+		for i, conn := range connectors {
+			ci := make(map[string]interface{})
+			ci["id"] = strconv.Itoa(conn.ID)
+			ci["name"] = conn.Name
+			ci["type"] = string(conn.Type)
+			ci["state"] = string(conn.State)
+			ci["pipeline_id"] = conn.PipelineID
+			ci["pipeline_name"] = conn.PipelineName
+			ci["metadata"] = flattenStringMap(conn.Metadata)
+			ci["streams"] = flattenStreams(conn)
+
+			cMap[i] = ci
+		}
+		return cMap
+	}
+	return make([]interface{}, 0)
+}
@@ -0,0 +1,76 @@
+package meroxa
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestDataMeroxaConnectors(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+
+	api.mu.Lock()
+	for _, conn := range []*meroxa.Connector{
+		{
+			Name: "orders-source", Type: meroxa.ConnectorTypeSource, State: meroxa.ConnectorStateRunning,
+			PipelineID: 1, PipelineName: "orders", Metadata: map[string]interface{}{"team": "data"},
+		},
+		{
+			Name: "orders-sink", Type: meroxa.ConnectorTypeDestination, State: meroxa.ConnectorStatePaused,
+			PipelineID: 1, PipelineName: "orders", Metadata: map[string]interface{}{"team": "analytics"},
+		},
+		{
+			Name: "users-source", Type: meroxa.ConnectorTypeSource, State: meroxa.ConnectorStatePaused,
+			PipelineID: 2, PipelineName: "users",
+		},
+	} {
+		// Listed connectors may come without streams
+		conn.ID = api.id()
+		api.connectors[conn.ID] = conn
+	}
+	api.mu.Unlock()
+
+	tests := []struct {
+		desc string
+		raw  map[string]interface{}
+		want []string
+	}{
+		{desc: "no filter", raw: map[string]interface{}{}, want: []string{"orders-sink", "orders-source", "users-source"}},
+		{desc: "name regex", raw: map[string]interface{}{"name_regex": "^orders-"}, want: []string{"orders-sink", "orders-source"}},
+		{desc: "type", raw: map[string]interface{}{"type": "source"}, want: []string{"orders-source", "users-source"}},
+		{desc: "state", raw: map[string]interface{}{"state": "paused"}, want: []string{"orders-sink", "users-source"}},
+		{desc: "pipeline id", raw: map[string]interface{}{"pipeline_id": 2}, want: []string{"users-source"}},
+		{desc: "pipeline name", raw: map[string]interface{}{"pipeline_name": "orders"}, want: []string{"orders-sink", "orders-source"}},
+		{
+			desc: "metadata",
+			raw:  map[string]interface{}{"metadata": map[string]interface{}{"team": "data"}},
+			want: []string{"orders-source"},
+		},
+		{
+			desc: "combined",
+			raw:  map[string]interface{}{"type": "source", "state": "paused"},
+			want: []string{"users-source"},
+		},
+		{desc: "no match", raw: map[string]interface{}{"name_regex": "^invoices"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := testResourceData(t, dataSourceConnectors(), tt.raw)
+			requireNoError(t, dataSourceConnectorsRead(context.Background(), d, meta))
+
+			got := make([]string, 0)
+			for _, conn := range d.Get("connectors").([]interface{}) {
+				got = append(got, conn.(map[string]interface{})["name"].(string))
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got connectors %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
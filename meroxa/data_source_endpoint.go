@@ -0,0 +1,85 @@
+package meroxa
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Endpoint name",
+				Required:    true,
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Description: "Endpoint protocol",
+				Computed:    true,
+			},
+			"stream": {
+				Type:        schema.TypeString,
+				Description: "Stream the endpoint reads from",
+				Computed:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Endpoint host",
+				Computed:    true,
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Description: "Whether the endpoint is ready to accept connections",
+				Computed:    true,
+			},
+			"basic_auth_username": {
+				Type:        schema.TypeString,
+				Description: "Endpoint basic auth username",
+				Computed:    true,
+			},
+			"basic_auth_password": {
+				Type:        schema.TypeString,
+				Description: "Endpoint basic auth password",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"url": {
+				Type: schema.TypeString,
+				Description: "Endpoint URL including the basic auth credentials for `HTTP` endpoints, " +
+					"the endpoint host for `GRPC` endpoints",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	end, err := c.GetEndpoint(ctx, d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", end.Name)
+	_ = d.Set("protocol", string(end.Protocol))
+	_ = d.Set("stream", end.Stream)
+	_ = d.Set("host", end.Host)
+	_ = d.Set("ready", end.Ready)
+	_ = d.Set("basic_auth_username", end.BasicAuthUsername)
+	_ = d.Set("basic_auth_password", end.BasicAuthPassword)
+	_ = d.Set("url", endpointURL(end))
+
+	// always run
+	d.SetId(end.Name)
+	return diags
+}
@@ -0,0 +1,139 @@
+package meroxa
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:             schema.TypeString,
+				Description:      "Only return endpoints using this protocol",
+				Optional:         true,
+				ValidateDiagFunc: validateStringInSlice("endpoint protocol", endpointProtocols),
+			},
+			"stream": {
+				Type:        schema.TypeString,
+				Description: "Only return endpoints reading from this stream",
+				Optional:    true,
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Description: "Only return endpoints with this readiness",
+				Optional:    true,
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Description: "List of Endpoints",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint name",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint protocol",
+						},
+						"stream": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Stream the endpoint reads from",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint host",
+						},
+						"ready": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the endpoint is ready to accept connections",
+						},
+						"basic_auth_username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint basic auth username",
+						},
+						"basic_auth_password": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "Endpoint basic auth password",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	endpoints, err := c.ListEndpoints(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	protocol := d.Get("protocol").(string)
+	stream := d.Get("stream").(string)
+	// ready is a tri-state filter, an unset value must not be read as false
+	filterReady := !d.GetRawConfig().IsNull() && !d.GetRawConfig().GetAttr("ready").IsNull()
+	ready := d.Get("ready").(bool)
+
+	filtered := make([]meroxa.Endpoint, 0, len(endpoints))
+	for _, end := range endpoints {
+		if protocol != "" && string(end.Protocol) != protocol {
+			continue
+		}
+		if stream != "" && end.Stream != stream {
+			continue
+		}
+		if filterReady && end.Ready != ready {
+			continue
+		}
+		filtered = append(filtered, end)
+	}
+
+	if err = d.Set("endpoints", flattenEndpoints(filtered)); err != nil {
+		return diag.FromErr(err)
+	}
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func flattenEndpoints(endpoints []meroxa.Endpoint) []interface{} {
+	if endpoints != nil {
+		eMap := make([]interface{}, len(endpoints))
+		for i, end := range endpoints {
+			ei := make(map[string]interface{})
+			ei["name"] = end.Name
+			ei["protocol"] = string(end.Protocol)
+			ei["stream"] = end.Stream
+			ei["host"] = end.Host
+			ei["ready"] = end.Ready
+			ei["basic_auth_username"] = end.BasicAuthUsername
+			ei["basic_auth_password"] = end.BasicAuthPassword
+
+			eMap[i] = ei
+		}
+		return eMap
+	}
+	return make([]interface{}, 0)
+}
@@ -0,0 +1,73 @@
+package meroxa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestDataMeroxaEndpoints(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+
+	api.mu.Lock()
+	for _, end := range []*meroxa.Endpoint{
+		{Name: "orders-http", Protocol: meroxa.EndpointProtocolHttp, Stream: "orders", Ready: true},
+		{Name: "orders-grpc", Protocol: meroxa.EndpointProtocolGrpc, Stream: "orders", Ready: false},
+		{Name: "users-http", Protocol: meroxa.EndpointProtocolHttp, Stream: "users", Ready: false},
+	} {
+		api.endpoints[end.Name] = end
+	}
+	api.mu.Unlock()
+
+	tests := []struct {
+		desc string
+		raw  map[string]interface{}
+		want []string
+	}{
+		{desc: "no filter", raw: map[string]interface{}{}, want: []string{"orders-grpc", "orders-http", "users-http"}},
+		{desc: "protocol", raw: map[string]interface{}{"protocol": "HTTP"}, want: []string{"orders-http", "users-http"}},
+		{desc: "stream", raw: map[string]interface{}{"stream": "orders"}, want: []string{"orders-grpc", "orders-http"}},
+		{desc: "ready", raw: map[string]interface{}{"ready": true}, want: []string{"orders-http"}},
+		{desc: "not ready", raw: map[string]interface{}{"ready": false}, want: []string{"orders-grpc", "users-http"}},
+		{desc: "combined", raw: map[string]interface{}{"protocol": "HTTP", "ready": false}, want: []string{"users-http"}},
+		{desc: "no match", raw: map[string]interface{}{"stream": "invoices"}, want: []string{}},
+	}
+
+	r := dataSourceEndpoints()
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			// The ready filter reads the raw config to tell false from unset
+			b, err := json.Marshal(tt.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			config, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+			attrs := make(map[string]string, len(tt.raw))
+			for k, v := range tt.raw {
+				attrs[k] = fmt.Sprint(v)
+			}
+			d := r.Data(&terraform.InstanceState{Attributes: attrs, RawConfig: config})
+			requireNoError(t, dataSourceEndpointsRead(context.Background(), d, meta))
+
+			got := make([]string, 0)
+			for _, end := range d.Get("endpoints").([]interface{}) {
+				got = append(got, end.(map[string]interface{})["name"].(string))
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got endpoints %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
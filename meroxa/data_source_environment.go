@@ -0,0 +1,109 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEnvironmentRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "Environment name",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "uuid"},
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Description: "Environment UUID",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Description: "Environment cloud provider",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Environment region",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Environment type",
+				Computed:    true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "Environment configuration",
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Environment status",
+				Computed:    true,
+			},
+			"status_details": {
+				Type:        schema.TypeString,
+				Description: "Environment status details",
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Environment Created at timestamp",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "Environment Updated at timestamp",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	nameOrUUID := d.Get("uuid").(string)
+	if nameOrUUID == "" {
+		nameOrUUID = d.Get("name").(string)
+	}
+
+	env, err := c.GetEnvironment(ctx, nameOrUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("uuid", env.UUID)
+	_ = d.Set("name", env.Name)
+	_ = d.Set("cloud_provider", string(env.Provider))
+	_ = d.Set("region", string(env.Region))
+	_ = d.Set("type", string(env.Type))
+	_ = d.Set("status", string(env.Status.State))
+	_ = d.Set("status_details", env.Status.Details)
+	_ = d.Set("created_at", env.CreatedAt.String())
+	_ = d.Set("updated_at", env.UpdatedAt.String())
+
+	err = d.Set("config", flattenStringMap(env.Configuration))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting config: %s", err))
+	}
+
+	// always run
+	d.SetId(env.UUID)
+	return diags
+}
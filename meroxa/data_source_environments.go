@@ -0,0 +1,157 @@
+package meroxa
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceEnvironments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEnvironmentsRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:             schema.TypeString,
+				Description:      "Only return environments in this region",
+				Optional:         true,
+				ValidateDiagFunc: validateStringInSlice("environment region", environmentRegions),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Description:      "Only return environments of this type",
+				Optional:         true,
+				ValidateDiagFunc: validateStringInSlice("environment type", environmentTypes),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Only return environments in this state",
+				Optional:    true,
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Description: "List of Environments",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment UUID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment name",
+						},
+						"cloud_provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment cloud provider",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment region",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment type",
+						},
+						"config": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "Environment configuration",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment status",
+						},
+						"status_details": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment status details",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment Created at timestamp",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Environment Updated at timestamp",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	environments, err := c.ListEnvironments(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	envType := d.Get("type").(string)
+	state := d.Get("state").(string)
+
+	filtered := make([]*meroxa.Environment, 0, len(environments))
+	for _, env := range environments {
+		if region != "" && string(env.Region) != region {
+			continue
+		}
+		if envType != "" && string(env.Type) != envType {
+			continue
+		}
+		if state != "" && string(env.Status.State) != state {
+			continue
+		}
+		filtered = append(filtered, env)
+	}
+
+	if err = d.Set("environments", flattenEnvironments(filtered)); err != nil {
+		return diag.FromErr(err)
+	}
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func flattenEnvironments(environments []*meroxa.Environment) []interface{} {
+	if environments != nil {
+		eMap := make([]interface{}, len(environments))
+		for i, env := range environments {
+			ei := make(map[string]interface{})
+			ei["uuid"] = env.UUID
+			ei["name"] = env.Name
+			ei["cloud_provider"] = string(env.Provider)
+			ei["region"] = string(env.Region)
+			ei["type"] = string(env.Type)
+			ei["config"] = flattenStringMap(env.Configuration)
+			ei["status"] = string(env.Status.State)
+			ei["status_details"] = env.Status.Details
+			ei["created_at"] = env.CreatedAt.String()
+			ei["updated_at"] = env.UpdatedAt.String()
+
+			eMap[i] = ei
+		}
+		return eMap
+	}
+	return make([]interface{}, 0)
+}
@@ -0,0 +1,30 @@
+package meroxa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataMeroxaEnvironments_filtered(t *testing.T) {
+	datasourceAddress := "data.meroxa_environments.provisioned"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataMeroxaEnvironments,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists(datasourceAddress),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataMeroxaEnvironments = `
+data "meroxa_environments" "provisioned" {
+  region = "us-east-1"
+  state  = "provisioned"
+}
+`
@@ -2,6 +2,7 @@ package meroxa
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +29,7 @@ func dataSourcePipeline() *schema.Resource {
 				Description: "Pipeline state",
 				Computed:    true,
 			},
+			"connectors": pipelineConnectorsSchema(),
 		},
 	}
 }
@@ -52,5 +54,14 @@ func dataSourcePipelineRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("name", p.Name)
 	_ = d.Set("state", string(p.State))
 
+	conns, err := c.ListPipelineConnectors(ctx, p.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("connectors", flattenPipelineConnectors(conns)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting connectors: %s", err))
+	}
+
 	return diags
 }
@@ -0,0 +1,125 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourcePipelines() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePipelinesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "Only return pipelines whose name matches this regular expression",
+				Optional:         true,
+				ValidateDiagFunc: validateRegexp(),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Only return pipelines in this state",
+				Optional:    true,
+			},
+			"pipelines": {
+				Type:        schema.TypeList,
+				Description: "List of Pipelines",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Pipeline ID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Pipeline name",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Pipeline state",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePipelinesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	pipelines, err := c.ListPipelines(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	state := d.Get("state").(string)
+
+	filtered := make([]*meroxa.Pipeline, 0, len(pipelines))
+	for _, p := range pipelines {
+		if !nameRegex.MatchString(p.Name) {
+			continue
+		}
+		if state != "" && string(p.State) != state {
+			continue
+		}
+		filtered = append(filtered, p)
+	}
+
+	if err = d.Set("pipelines", flattenPipelines(filtered)); err != nil {
+		return diag.FromErr(err)
+	}
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func flattenPipelines(pipelines []*meroxa.Pipeline) []interface{} {
+	if pipelines != nil {
+		pMap := make([]interface{}, len(pipelines))
+		for i, p := range pipelines {
+			pi := make(map[string]interface{})
+			pi["id"] = strconv.Itoa(p.ID)
+			pi["name"] = p.Name
+			pi["state"] = string(p.State)
+
+			pMap[i] = pi
+		}
+		return pMap
+	}
+	return make([]interface{}, 0)
+}
+
+func validateRegexp() schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if _, err := regexp.Compile(val.(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid regular expression",
+				Detail:        fmt.Sprintf("%q is not a valid regular expression: %s", val.(string), err),
+				AttributePath: path,
+			})
+		}
+		return diags
+	}
+}
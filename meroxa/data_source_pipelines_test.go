@@ -0,0 +1,88 @@
+package meroxa
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestAccDataMeroxaPipelines_nameRegex(t *testing.T) {
+	datasourceAddress := "data.meroxa_pipelines.filtered"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMeroxaPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataMeroxaPipelines,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists(datasourceAddress),
+					resource.TestCheckResourceAttr(datasourceAddress, "pipelines.#", "1"),
+					resource.TestCheckResourceAttr(datasourceAddress, "pipelines.0.name", "pipelines-data-source"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataMeroxaPipelines(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+
+	api.mu.Lock()
+	for _, p := range []*meroxa.Pipeline{
+		{Name: "orders", State: meroxa.PipelineStateHealthy},
+		{Name: "orders-archive", State: meroxa.PipelineStateDegraded},
+		{Name: "users", State: meroxa.PipelineStateHealthy},
+	} {
+		p.ID = api.id()
+		api.pipelines[p.ID] = p
+	}
+	api.mu.Unlock()
+
+	tests := []struct {
+		desc string
+		raw  map[string]interface{}
+		want []string
+	}{
+		{desc: "no filter", raw: map[string]interface{}{}, want: []string{"orders", "orders-archive", "users"}},
+		{desc: "name regex", raw: map[string]interface{}{"name_regex": "^orders"}, want: []string{"orders", "orders-archive"}},
+		{desc: "state", raw: map[string]interface{}{"state": "healthy"}, want: []string{"orders", "users"}},
+		{
+			desc: "combined",
+			raw:  map[string]interface{}{"name_regex": "^orders", "state": "healthy"},
+			want: []string{"orders"},
+		},
+		{desc: "no match", raw: map[string]interface{}{"name_regex": "^invoices"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := testResourceData(t, dataSourcePipelines(), tt.raw)
+			requireNoError(t, dataSourcePipelinesRead(context.Background(), d, meta))
+
+			got := make([]string, 0)
+			for _, p := range d.Get("pipelines").([]interface{}) {
+				got = append(got, p.(map[string]interface{})["name"].(string))
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got pipelines %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+const testAccDataMeroxaPipelines = `
+resource "meroxa_pipeline" "filtered" {
+  name = "pipelines-data-source"
+}
+
+data "meroxa_pipelines" "filtered" {
+  name_regex = "^${meroxa_pipeline.filtered.name}$"
+}
+`
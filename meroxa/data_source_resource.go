@@ -62,6 +62,16 @@ func dataSourceResource() *schema.Resource {
 				Description: "Resource Status",
 				Computed:    true,
 			},
+			"status_details": {
+				Type:        schema.TypeString,
+				Description: "Resource Status details",
+				Computed:    true,
+			},
+			"status_last_updated_at": {
+				Type:        schema.TypeString,
+				Description: "Resource Status Last updated at timestamp",
+				Computed:    true,
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: "Resource Created at timestamp",
@@ -143,6 +153,8 @@ func dataSourceResourceRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("url", r.URL)
 	_ = d.Set("metadata", r.Metadata)
 	_ = d.Set("status", string(r.Status.State)) //todo flatten
+	_ = d.Set("status_details", r.Status.Details)
+	_ = d.Set("status_last_updated_at", r.Status.LastUpdatedAt.String())
 	_ = d.Set("created_at", r.CreatedAt.String())
 	_ = d.Set("updated_at", r.UpdatedAt.String())
 
@@ -0,0 +1,184 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Description:      "Only return resources whose name matches this regular expression",
+				Optional:         true,
+				ValidateDiagFunc: validateRegexp(),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Only return resources of this type",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Only return resources with this status",
+				Optional:    true,
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Description: "Only return resources whose metadata contains all of these key/value pairs",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Description: "List of Resources",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource ID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource Name",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource Type",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource URL",
+						},
+						"metadata": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Resource Metadata",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource Status",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource Created at timestamp",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource Updated at timestamp",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	resources, err := c.ListResources(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rType := d.Get("type").(string)
+	status := d.Get("status").(string)
+	metadata := d.Get("metadata").(map[string]interface{})
+
+	filtered := make([]*meroxa.Resource, 0, len(resources))
+	for _, r := range resources {
+		if !nameRegex.MatchString(r.Name) {
+			continue
+		}
+		if rType != "" && string(r.Type) != rType {
+			continue
+		}
+		if status != "" && string(r.Status.State) != status {
+			continue
+		}
+		if !metadataMatches(r.Metadata, metadata) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	if err = d.Set("resources", flattenResources(filtered)); err != nil {
+		return diag.FromErr(err)
+	}
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	return diags
+}
+
+func flattenResources(resources []*meroxa.Resource) []interface{} {
+	if resources != nil {
+		rMap := make([]interface{}, len(resources))
+		for i, r := range resources {
+			ri := make(map[string]interface{})
+			ri["id"] = strconv.Itoa(r.ID)
+			ri["name"] = r.Name
+			ri["type"] = string(r.Type)
+			ri["url"] = r.URL
+			ri["metadata"] = flattenStringMap(r.Metadata)
+			ri["status"] = string(r.Status.State)
+			ri["created_at"] = r.CreatedAt.String()
+			ri["updated_at"] = r.UpdatedAt.String()
+
+			rMap[i] = ri
+		}
+		return rMap
+	}
+	return make([]interface{}, 0)
+}
+
+// metadataMatches reports whether every key/value pair of filter is present
+// in metadata.
+func metadataMatches(metadata, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		mv, ok := metadata[k]
+		if !ok || fmt.Sprint(mv) != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenStringMap stringifies the values of a free-form map returned by the
+// API so that they fit into a map of strings.
+func flattenStringMap(m map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			c[k] = s
+			continue
+		}
+		c[k] = fmt.Sprint(v)
+	}
+	return c
+}
@@ -0,0 +1,73 @@
+package meroxa
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestDataMeroxaResources(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+
+	api.mu.Lock()
+	for _, res := range []*meroxa.Resource{
+		{
+			Name: "orders-pg", Type: meroxa.ResourceTypePostgres,
+			Status: meroxa.ResourceStatus{State: meroxa.ResourceStateReady}, Metadata: map[string]interface{}{"team": "data"},
+		},
+		{
+			Name: "orders-s3", Type: meroxa.ResourceTypeS3,
+			Status: meroxa.ResourceStatus{State: meroxa.ResourceStateError}, Metadata: map[string]interface{}{"team": "analytics"},
+		},
+		{
+			Name: "users-pg", Type: meroxa.ResourceTypePostgres,
+			Status: meroxa.ResourceStatus{State: meroxa.ResourceStateError},
+		},
+	} {
+		res.ID = api.id()
+		api.resources[res.ID] = res
+	}
+	api.mu.Unlock()
+
+	tests := []struct {
+		desc string
+		raw  map[string]interface{}
+		want []string
+	}{
+		{desc: "no filter", raw: map[string]interface{}{}, want: []string{"orders-pg", "orders-s3", "users-pg"}},
+		{desc: "name regex", raw: map[string]interface{}{"name_regex": "^orders-"}, want: []string{"orders-pg", "orders-s3"}},
+		{desc: "type", raw: map[string]interface{}{"type": "postgres"}, want: []string{"orders-pg", "users-pg"}},
+		{desc: "status", raw: map[string]interface{}{"status": "error"}, want: []string{"orders-s3", "users-pg"}},
+		{
+			desc: "metadata",
+			raw:  map[string]interface{}{"metadata": map[string]interface{}{"team": "analytics"}},
+			want: []string{"orders-s3"},
+		},
+		{
+			desc: "combined",
+			raw:  map[string]interface{}{"type": "postgres", "status": "error"},
+			want: []string{"users-pg"},
+		},
+		{desc: "no match", raw: map[string]interface{}{"type": "mysql"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := testResourceData(t, dataSourceResources(), tt.raw)
+			requireNoError(t, dataSourceResourcesRead(context.Background(), d, meta))
+
+			got := make([]string, 0)
+			for _, res := range d.Get("resources").([]interface{}) {
+				got = append(got, res.(map[string]interface{})["name"].(string))
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got resources %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package meroxa
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -27,3 +28,18 @@ func TestAccDataAPGroup_default(t *testing.T) {
 const testAccDataMeroxaTransforms = `
 data "meroxa_transforms" "default" {}
 `
+
+func TestMeroxaTransforms_read(t *testing.T) {
+	api := newFakeAPI(t)
+	r := dataSourceTransforms()
+
+	d := testResourceData(t, r, nil)
+	requireNoError(t, r.ReadContext(context.Background(), d, api.meta()))
+
+	if got := d.Get("transforms.#"); got != 1 {
+		t.Fatalf("got %v transforms, want 1", got)
+	}
+	if got := d.Get("transforms.0.name"); got != "ValueToKey" {
+		t.Errorf("got transform %q, want ValueToKey", got)
+	}
+}
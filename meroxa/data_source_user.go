@@ -0,0 +1,87 @@
+package meroxa
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "The account the provider is authenticated as.",
+		ReadContext: dataSourceUserRead,
+		Schema: map[string]*schema.Schema{
+			"uuid": {
+				Type:        schema.TypeString,
+				Description: "User UUID",
+				Computed:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "User username",
+				Computed:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "User email",
+				Computed:    true,
+			},
+			"given_name": {
+				Type:        schema.TypeString,
+				Description: "User given name",
+				Computed:    true,
+			},
+			"family_name": {
+				Type:        schema.TypeString,
+				Description: "User family name",
+				Computed:    true,
+			},
+			"email_verified": {
+				Type:        schema.TypeBool,
+				Description: "Whether the user email has been verified",
+				Computed:    true,
+			},
+			"last_login": {
+				Type:        schema.TypeString,
+				Description: "User Last login timestamp",
+				Computed:    true,
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Description: "Feature flags enabled for the user",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(meroxa.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	u, err := c.GetUser(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("uuid", u.UUID)
+	_ = d.Set("username", u.Username)
+	_ = d.Set("email", u.Email)
+	_ = d.Set("given_name", u.GivenName)
+	_ = d.Set("family_name", u.FamilyName)
+	_ = d.Set("email_verified", u.Verified)
+	_ = d.Set("last_login", u.LastLogin.String())
+
+	if err = d.Set("features", u.Features); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(u.UUID)
+	return diags
+}
@@ -0,0 +1,29 @@
+package meroxa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataMeroxaUser_default(t *testing.T) {
+	datasourceAddress := "data.meroxa_user.current"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataMeroxaUser,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists(datasourceAddress),
+					resource.TestCheckResourceAttrSet(datasourceAddress, "uuid"),
+					resource.TestCheckResourceAttrSet(datasourceAddress, "email"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataMeroxaUser = `
+data "meroxa_user" "current" {}
+`
@@ -0,0 +1,50 @@
+package meroxa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// apiErrorCodeNotFound is the code the Meroxa API returns for missing objects.
+const apiErrorCodeNotFound = "not_found"
+
+// apiError mirrors the error body of the Meroxa API. The client does not
+// export its error type, so the code is recovered through its JSON tags.
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+// isNotFound reports whether err is the API telling the object does not exist.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var e apiError
+	if b, mErr := json.Marshal(err); mErr == nil && json.Unmarshal(b, &e) == nil && e.Code == apiErrorCodeNotFound {
+		return true
+	}
+
+	// Error bodies which are not JSON are reported as the response status line
+	return strings.HasSuffix(err.Error(), fmt.Sprintf("%d %s", http.StatusNotFound, http.StatusText(http.StatusNotFound)))
+}
+
+// notFoundWarning tells the user an object vanished outside of Terraform and
+// was removed from the state.
+func notFoundWarning(kind, id string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s (%s) not found", kind, id),
+		Detail: fmt.Sprintf("The %s was deleted outside of Terraform and has been removed from the state. "+
+			"It will be created again on the next apply.", strings.ToLower(kind)),
+	}
+}
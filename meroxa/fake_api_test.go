@@ -0,0 +1,779 @@
+package meroxa
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+// fakeAPI is an in-memory stand-in for the Meroxa API, so the provider can be
+// tested without network access or a Meroxa account.
+//
+// Objects are ready as soon as they are created unless states were scripted
+// for them, in which case every read of the object moves it to the next
+// scripted state.
+type fakeAPI struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu     sync.Mutex
+	nextID int
+
+	resources    map[int]*meroxa.Resource
+	connectors   map[int]*meroxa.Connector
+	pipelines    map[int]*meroxa.Pipeline
+	environments map[string]*meroxa.Environment
+	endpoints    map[string]*meroxa.Endpoint
+
+	resourceTypes []string
+	transforms    []*meroxa.Transform
+	user          *meroxa.User
+	logs          map[string]string
+
+	// states holds the states objects go through, keyed by kind and name.
+	states map[string][]string
+	// failures holds the status codes answered to the next requests, keyed by
+	// method and path.
+	failures map[string][]int
+	// requests records every request received as "METHOD /path".
+	requests []string
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	t.Helper()
+
+	api := &fakeAPI{
+		t:            t,
+		resources:    make(map[int]*meroxa.Resource),
+		connectors:   make(map[int]*meroxa.Connector),
+		pipelines:    make(map[int]*meroxa.Pipeline),
+		environments: make(map[string]*meroxa.Environment),
+		endpoints:    make(map[string]*meroxa.Endpoint),
+		resourceTypes: []string{
+			string(meroxa.ResourceTypePostgres),
+			string(meroxa.ResourceTypeMysql),
+			string(meroxa.ResourceTypeS3),
+			string(meroxa.ResourceTypeSnowflake),
+		},
+		transforms: []*meroxa.Transform{
+			{
+				ID:         1,
+				Name:       "ValueToKey",
+				Type:       "builtin",
+				Properties: []meroxa.Property{{Name: "fields", Required: true, Type: "string"}},
+			},
+		},
+		logs:     make(map[string]string),
+		states:   make(map[string][]string),
+		failures: make(map[string][]int),
+	}
+	api.user = &meroxa.User{
+		UUID:     api.uuid(),
+		Username: "fake",
+		Email:    "fake@meroxa.io",
+		Verified: true,
+	}
+	api.srv = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.srv.Close)
+
+	return api
+}
+
+// script queues the states the object of the given kind ("resources",
+// "connectors", "pipelines", "environments" or "endpoints") and name goes
+// through, starting with its creation or its next action.
+func (api *fakeAPI) script(kind, name string, states ...string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.states[kind+"/"+name] = append(api.states[kind+"/"+name], states...)
+}
+
+// fail answers the next requests to method and path with the given status
+// codes, one per request.
+func (api *fakeAPI) fail(method, path string, statuses ...int) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.failures[method+" "+path] = append(api.failures[method+" "+path], statuses...)
+}
+
+// count returns how many requests were made to method and path.
+func (api *fakeAPI) count(method, path string) int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	n := 0
+	for _, r := range api.requests {
+		if r == method+" "+path {
+			n++
+		}
+	}
+	return n
+}
+
+// meta returns the configured provider meta, pointed at the fake through
+// api_url.
+func (api *fakeAPI) meta() interface{} {
+	api.t.Helper()
+	return api.metaWith(nil)
+}
+
+// metaWith configures the provider against the fake API with extra settings.
+func (api *fakeAPI) metaWith(config map[string]interface{}) interface{} {
+	api.t.Helper()
+
+	raw := map[string]interface{}{
+		"access_token":  fakeAccessToken(),
+		"api_url":       api.srv.URL,
+		"poll_interval": "1ms",
+		"max_backoff":   "1s",
+	}
+	for k, v := range config {
+		raw[k] = v
+	}
+
+	p := Provider("test")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		api.t.Fatalf("configuring provider: %v", diags)
+	}
+	return p.Meta()
+}
+
+// fakeAccessToken returns an unsigned JWT, the client only reads its expiry.
+func fakeAccessToken() string {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	claims := enc.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(time.Hour).Unix())))
+	return header + "." + claims + "." + enc.EncodeToString([]byte("fake"))
+}
+
+func (api *fakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	key := r.Method + " " + r.URL.Path
+	api.requests = append(api.requests, key)
+
+	if statuses := api.failures[key]; len(statuses) > 0 {
+		api.failures[key] = statuses[1:]
+		w.Header().Set("Retry-After", "0")
+		api.error(w, statuses[0], "scripted failure")
+		return
+	}
+
+	// e.g. ["resources", "pg", "actions"]
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+	switch parts[0] {
+	case "resources":
+		api.serveResources(w, r, parts[1:])
+	case "connectors":
+		api.serveConnectors(w, r, parts[1:])
+	case "pipelines":
+		api.servePipelines(w, r, parts[1:])
+	case "environments":
+		api.serveEnvironments(w, r, parts[1:])
+	case "endpoints":
+		api.serveEndpoints(w, r, parts[1:])
+	case "resource-types":
+		api.write(w, http.StatusOK, api.resourceTypes)
+	case "transforms":
+		api.write(w, http.StatusOK, api.transforms)
+	case "users":
+		api.write(w, http.StatusOK, api.user)
+	default:
+		api.error(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	}
+}
+
+func (api *fakeAPI) serveResources(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]*meroxa.Resource, 0, len(api.resources))
+			for _, res := range api.resources {
+				list = append(list, res)
+			}
+			api.write(w, http.StatusOK, list)
+		case http.MethodPost:
+			var in meroxa.CreateResourceInput
+			if !api.read(w, r, &in) {
+				return
+			}
+			if api.findResource(in.Name) != nil {
+				api.error(w, http.StatusUnprocessableEntity, fmt.Sprintf("resource %q already exists", in.Name))
+				return
+			}
+			now := time.Now().UTC()
+			res := &meroxa.Resource{
+				ID:          api.id(),
+				Type:        in.Type,
+				Name:        in.Name,
+				URL:         in.URL,
+				Credentials: in.Credentials,
+				Metadata:    in.Metadata,
+				Status:      meroxa.ResourceStatus{State: meroxa.ResourceStateReady, LastUpdatedAt: now},
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}
+			if in.SSHTunnel != nil {
+				res.SSHTunnel = &meroxa.ResourceSSHTunnel{Address: in.SSHTunnel.Address, PublicKey: api.publicKey()}
+			}
+			api.resources[res.ID] = res
+			api.advanceResource(res)
+			api.write(w, http.StatusOK, res)
+		default:
+			api.error(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	res := api.findResource(parts[0])
+	if res == nil {
+		api.notFound(w, "resource", parts[0])
+		return
+	}
+
+	switch {
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodPost:
+		var in struct {
+			Action string `json:"action"`
+		}
+		if !api.read(w, r, &in) {
+			return
+		}
+		switch in.Action {
+		case "validate":
+			// Validation runs in the background, the status only changes
+			// with the next scripted states
+			if key := "resources/" + res.Name; len(api.states[key]) == 0 {
+				api.states[key] = []string{string(meroxa.ResourceStatePending), string(meroxa.ResourceStateReady)}
+			}
+		case "rotate_keys":
+			if res.SSHTunnel == nil {
+				api.error(w, http.StatusUnprocessableEntity, "resource has no ssh tunnel")
+				return
+			}
+			res.SSHTunnel.PublicKey = api.publicKey()
+		default:
+			api.error(w, http.StatusBadRequest, "unknown action "+in.Action)
+			return
+		}
+		api.write(w, http.StatusOK, res)
+	case len(parts) > 1:
+		api.error(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	case r.Method == http.MethodGet:
+		api.advanceResource(res)
+		api.write(w, http.StatusOK, res)
+	case r.Method == http.MethodPatch:
+		var in meroxa.UpdateResourceInput
+		if !api.read(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			res.Name = in.Name
+		}
+		if in.URL != "" {
+			res.URL = in.URL
+		}
+		if in.Credentials != nil {
+			res.Credentials = in.Credentials
+		}
+		if in.Metadata != nil {
+			res.Metadata = in.Metadata
+		}
+		if in.SSHTunnel != nil {
+			res.SSHTunnel = &meroxa.ResourceSSHTunnel{Address: in.SSHTunnel.Address, PublicKey: api.publicKey()}
+		}
+		res.UpdatedAt = time.Now().UTC()
+		api.write(w, http.StatusOK, res)
+	case r.Method == http.MethodDelete:
+		delete(api.resources, res.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		api.error(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (api *fakeAPI) serveConnectors(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]*meroxa.Connector, 0, len(api.connectors))
+			for _, conn := range api.connectors {
+				list = append(list, conn)
+			}
+			api.write(w, http.StatusOK, list)
+		case http.MethodPost:
+			var in meroxa.CreateConnectorInput
+			if !api.read(w, r, &in) {
+				return
+			}
+			if _, ok := api.resources[in.ResourceID]; !ok {
+				api.notFound(w, "resource", strconv.Itoa(in.ResourceID))
+				return
+			}
+			p := api.pipelines[in.PipelineID]
+			if p == nil {
+				p = api.findPipeline(in.PipelineName)
+			}
+			if p == nil {
+				api.error(w, http.StatusUnprocessableEntity, "a pipeline is required")
+				return
+			}
+			if api.findConnector(in.Name) != nil {
+				api.error(w, http.StatusUnprocessableEntity, fmt.Sprintf("connector %q already exists", in.Name))
+				return
+			}
+			conn := &meroxa.Connector{
+				ID:            api.id(),
+				Type:          in.Type,
+				Name:          in.Name,
+				Configuration: in.Configuration,
+				Metadata:      in.Metadata,
+				State:         meroxa.ConnectorStateRunning,
+				PipelineID:    p.ID,
+				PipelineName:  p.Name,
+			}
+			stream := fmt.Sprintf("resource-%d-%s.%s", in.ResourceID, in.Name, in.Input)
+			if in.Type == meroxa.ConnectorTypeDestination {
+				conn.Streams = map[string]interface{}{"dynamic": false, "input": []interface{}{in.Input}}
+			} else {
+				conn.Streams = map[string]interface{}{"dynamic": false, "output": []interface{}{stream}}
+			}
+			api.connectors[conn.ID] = conn
+			api.advanceConnector(conn)
+			api.write(w, http.StatusOK, conn)
+		default:
+			api.error(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	conn := api.findConnector(parts[0])
+	if conn == nil {
+		api.notFound(w, "connector", parts[0])
+		return
+	}
+
+	switch {
+	case len(parts) == 2 && parts[1] == "status" && r.Method == http.MethodPost:
+		var in struct {
+			State meroxa.Action `json:"state"`
+		}
+		if !api.read(w, r, &in) {
+			return
+		}
+		switch in.State {
+		case meroxa.ActionPause:
+			conn.State = meroxa.ConnectorStatePaused
+		case meroxa.ActionResume, meroxa.ActionRestart:
+			conn.State = meroxa.ConnectorStateRunning
+		default:
+			api.error(w, http.StatusBadRequest, "unknown action "+string(in.State))
+			return
+		}
+		api.advanceConnector(conn)
+		api.write(w, http.StatusOK, conn)
+	case len(parts) == 2 && parts[1] == "logs" && r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(api.logs[conn.Name]))
+	case len(parts) > 1:
+		api.error(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	case r.Method == http.MethodGet:
+		api.advanceConnector(conn)
+		api.write(w, http.StatusOK, conn)
+	case r.Method == http.MethodPatch:
+		var in meroxa.UpdateConnectorInput
+		if !api.read(w, r, &in) {
+			return
+		}
+		if in.Name != "" {
+			conn.Name = in.Name
+		}
+		if in.Configuration != nil {
+			conn.Configuration = in.Configuration
+		}
+		api.write(w, http.StatusOK, conn)
+	case r.Method == http.MethodDelete:
+		delete(api.connectors, conn.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		api.error(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (api *fakeAPI) servePipelines(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			// GetPipelineByName filters the list endpoint and expects a single pipeline back
+			if name := r.URL.Query().Get("name"); name != "" {
+				p := api.findPipeline(name)
+				if p == nil {
+					api.notFound(w, "pipeline", name)
+					return
+				}
+				api.write(w, http.StatusOK, p)
+				return
+			}
+			list := make([]*meroxa.Pipeline, 0, len(api.pipelines))
+			for _, p := range api.pipelines {
+				list = append(list, p)
+			}
+			api.write(w, http.StatusOK, list)
+		case http.MethodPost:
+			var in meroxa.CreatePipelineInput
+			if !api.read(w, r, &in) {
+				return
+			}
+			if api.findPipeline(in.Name) != nil {
+				api.error(w, http.StatusUnprocessableEntity, fmt.Sprintf("pipeline %q already exists", in.Name))
+				return
+			}
+			p := &meroxa.Pipeline{ID: api.id(), Name: in.Name, State: meroxa.PipelineStateHealthy}
+			api.pipelines[p.ID] = p
+			api.advancePipeline(p)
+			api.write(w, http.StatusOK, p)
+		default:
+			api.error(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	id, _ := strconv.Atoi(parts[0])
+	p := api.pipelines[id]
+	if p == nil {
+		api.notFound(w, "pipeline", parts[0])
+		return
+	}
+
+	switch {
+	case len(parts) == 2 && parts[1] == "status" && r.Method == http.MethodPost:
+		var in struct {
+			State meroxa.Action `json:"state"`
+		}
+		if !api.read(w, r, &in) {
+			return
+		}
+		switch in.State {
+		case meroxa.ActionPause:
+			p.State = pipelineStatePaused
+		case meroxa.ActionResume:
+			p.State = meroxa.PipelineStateHealthy
+		default:
+			api.error(w, http.StatusBadRequest, "unknown action "+string(in.State))
+			return
+		}
+		api.advancePipeline(p)
+		api.write(w, http.StatusOK, p)
+	case len(parts) == 2 && parts[1] == "connectors" && r.Method == http.MethodGet:
+		list := make([]*meroxa.Connector, 0)
+		for _, conn := range api.connectors {
+			if conn.PipelineID == p.ID {
+				list = append(list, conn)
+			}
+		}
+		api.write(w, http.StatusOK, list)
+	case len(parts) > 1:
+		api.error(w, http.StatusNotFound, "unknown path "+r.URL.Path)
+	case r.Method == http.MethodGet:
+		api.advancePipeline(p)
+		api.write(w, http.StatusOK, p)
+	case r.Method == http.MethodPatch:
+		var in meroxa.UpdatePipelineInput
+		if !api.read(w, r, &in) {
+			return
+		}
+		p.Name = in.Name
+		for _, conn := range api.connectors {
+			if conn.PipelineID == p.ID {
+				conn.PipelineName = p.Name
+			}
+		}
+		api.write(w, http.StatusOK, p)
+	case r.Method == http.MethodDelete:
+		delete(api.pipelines, p.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		api.error(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (api *fakeAPI) serveEnvironments(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]*meroxa.Environment, 0, len(api.environments))
+			for _, env := range api.environments {
+				list = append(list, env)
+			}
+			api.write(w, http.StatusOK, list)
+		case http.MethodPost:
+			var in meroxa.CreateEnvironmentInput
+			if !api.read(w, r, &in) {
+				return
+			}
+			if api.findEnvironment(in.Name) != nil {
+				api.error(w, http.StatusUnprocessableEntity, fmt.Sprintf("environment %q already exists", in.Name))
+				return
+			}
+			now := time.Now().UTC()
+			env := &meroxa.Environment{
+				UUID:          api.uuid(),
+				Name:          in.Name,
+				Provider:      in.Provider,
+				Region:        in.Region,
+				Type:          in.Type,
+				Configuration: in.Configuration,
+				Status:        meroxa.EnvironmentViewStatus{State: meroxa.EnvironmentStateProvisioned},
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			}
+			api.environments[env.UUID] = env
+			api.advanceEnvironment(env)
+			api.write(w, http.StatusOK, env)
+		default:
+			api.error(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	env := api.findEnvironment(parts[0])
+	if env == nil || len(parts) > 1 {
+		api.notFound(w, "environment", parts[0])
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		api.advanceEnvironment(env)
+		api.write(w, http.StatusOK, env)
+	case http.MethodDelete:
+		// Deprovisioned environments are purged right away unless scripted
+		env.Status.State = meroxa.EnvironmentStateDeprovisioning
+		if len(api.states["environments/"+env.Name]) == 0 {
+			delete(api.environments, env.UUID)
+		}
+		api.write(w, http.StatusOK, env)
+	default:
+		api.error(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (api *fakeAPI) serveEndpoints(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]meroxa.Endpoint, 0, len(api.endpoints))
+			for _, end := range api.endpoints {
+				list = append(list, *end)
+			}
+			api.write(w, http.StatusOK, list)
+		case http.MethodPost:
+			var in meroxa.CreateEndpointInput
+			if !api.read(w, r, &in) {
+				return
+			}
+			if _, ok := api.endpoints[in.Name]; ok {
+				api.error(w, http.StatusUnprocessableEntity, fmt.Sprintf("endpoint %q already exists", in.Name))
+				return
+			}
+			end := &meroxa.Endpoint{
+				Name:     in.Name,
+				Protocol: in.Protocol,
+				Stream:   in.Stream,
+				Host:     in.Name + ".endpoints.meroxa.test",
+				Ready:    true,
+			}
+			if in.Protocol == meroxa.EndpointProtocolHttp {
+				end.BasicAuthUsername = "user"
+				end.BasicAuthPassword = "secret"
+			}
+			api.endpoints[end.Name] = end
+			api.advanceEndpoint(end)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			api.error(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	end := api.endpoints[parts[0]]
+	if end == nil || len(parts) > 1 {
+		api.notFound(w, "endpoint", parts[0])
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		api.advanceEndpoint(end)
+		api.write(w, http.StatusOK, end)
+	case http.MethodDelete:
+		delete(api.endpoints, end.Name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		api.error(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+// nextState pops the next scripted state of an object, if any.
+func (api *fakeAPI) nextState(kind, name string) (string, bool) {
+	key := kind + "/" + name
+	states := api.states[key]
+	if len(states) == 0 {
+		return "", false
+	}
+	api.states[key] = states[1:]
+	return states[0], true
+}
+
+func (api *fakeAPI) advanceResource(res *meroxa.Resource) {
+	// A state scripted again is the status not updated yet
+	if s, ok := api.nextState("resources", res.Name); ok && meroxa.ResourceState(s) != res.Status.State {
+		res.Status.State = meroxa.ResourceState(s)
+		res.Status.Details = ""
+		if res.Status.State == meroxa.ResourceStateError {
+			res.Status.Details = "scripted error"
+		}
+		res.Status.LastUpdatedAt = time.Now().UTC()
+	}
+}
+
+func (api *fakeAPI) advanceConnector(conn *meroxa.Connector) {
+	if s, ok := api.nextState("connectors", conn.Name); ok {
+		conn.State = meroxa.ConnectorState(s)
+	}
+}
+
+func (api *fakeAPI) advancePipeline(p *meroxa.Pipeline) {
+	if s, ok := api.nextState("pipelines", p.Name); ok {
+		p.State = meroxa.PipelineState(s)
+	}
+}
+
+func (api *fakeAPI) advanceEnvironment(env *meroxa.Environment) {
+	if s, ok := api.nextState("environments", env.Name); ok {
+		env.Status.State = meroxa.EnvironmentState(s)
+		env.Status.Details = ""
+		if env.Status.State == meroxa.EnvironmentStateError {
+			env.Status.Details = "scripted error"
+		}
+	}
+}
+
+func (api *fakeAPI) advanceEndpoint(end *meroxa.Endpoint) {
+	if s, ok := api.nextState("endpoints", end.Name); ok {
+		end.Ready = s == endpointStateReady
+	}
+}
+
+func (api *fakeAPI) findResource(nameOrID string) *meroxa.Resource {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return api.resources[id]
+	}
+	for _, res := range api.resources {
+		if res.Name == nameOrID {
+			return res
+		}
+	}
+	return nil
+}
+
+func (api *fakeAPI) findConnector(nameOrID string) *meroxa.Connector {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return api.connectors[id]
+	}
+	for _, conn := range api.connectors {
+		if conn.Name == nameOrID {
+			return conn
+		}
+	}
+	return nil
+}
+
+func (api *fakeAPI) findPipeline(name string) *meroxa.Pipeline {
+	for _, p := range api.pipelines {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (api *fakeAPI) findEnvironment(nameOrUUID string) *meroxa.Environment {
+	for _, env := range api.environments {
+		if env.UUID == nameOrUUID || env.Name == nameOrUUID {
+			return env
+		}
+	}
+	return nil
+}
+
+func (api *fakeAPI) id() int {
+	api.nextID++
+	return api.nextID
+}
+
+func (api *fakeAPI) uuid() string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", api.id())
+}
+
+func (api *fakeAPI) publicKey() string {
+	return fmt.Sprintf("ssh-rsa FAKEKEY%d", api.id())
+}
+
+func (api *fakeAPI) read(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		api.error(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func (api *fakeAPI) write(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		api.t.Errorf("fake API: encoding response: %s", err)
+	}
+}
+
+func (api *fakeAPI) error(w http.ResponseWriter, status int, msg string) {
+	code := strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+	api.write(w, status, apiError{Code: code, Message: msg})
+}
+
+func (api *fakeAPI) notFound(w http.ResponseWriter, kind, nameOrID string) {
+	api.write(w, http.StatusNotFound, apiError{
+		Code:    apiErrorCodeNotFound,
+		Message: fmt.Sprintf("could not find %s %q", kind, nameOrID),
+	})
+}
+
+// testResourceData builds the data of a resource or data source from raw
+// configuration values.
+func testResourceData(t *testing.T, r *schema.Resource, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	return schema.TestResourceDataRaw(t, r.Schema, raw)
+}
+
+// requireNoError fails the test if diags hold an error.
+func requireNoError(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+}
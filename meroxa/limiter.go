@@ -0,0 +1,105 @@
+package meroxa
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// limitTransport spaces requests out to stay below a request rate and caps
+// the number of requests in flight. It is shared by every resource and data
+// source of a provider instance, state waiters included.
+type limitTransport struct {
+	next http.RoundTripper
+
+	// interval is the minimum wait between the start of two requests. Zero
+	// means no rate limit.
+	interval time.Duration
+	// slots holds a token per request in flight, nil means no concurrency cap.
+	slots chan struct{}
+
+	mu sync.Mutex
+	// nextStart is the earliest time the next request may start.
+	nextStart time.Time
+}
+
+func newLimitTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrent int) *limitTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	t := &limitTransport{next: next}
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	defer t.release()
+
+	if err := t.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request is in flight until its body is consumed, the client never
+	// closes it so it is read before the slot is released
+	if err := bufferBody(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// wait blocks until the request is allowed to start according to the rate.
+func (t *limitTransport) wait(ctx context.Context) error {
+	if t.interval <= 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	if t.nextStart.Before(now) {
+		t.nextStart = now
+	}
+	wait := t.nextStart.Sub(now)
+	t.nextStart = t.nextStart.Add(t.interval)
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (t *limitTransport) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
@@ -0,0 +1,94 @@
+package meroxa
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+// platformMetadataPrefix marks metadata keys managed by the platform, e.g.
+// "mx:connectorType".
+const platformMetadataPrefix = "mx:"
+
+// mergeMetadata returns the provider default metadata overridden by metadata.
+func mergeMetadata(defaults, metadata map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(metadata))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range metadata {
+		merged[k] = v
+	}
+	return merged
+}
+
+// configuredMetadata strips the keys inherited from the provider defaults or
+// set by the platform from the metadata returned by the API, unless they were
+// configured on the object itself. It keeps them out of the plan.
+func configuredMetadata(all, defaults, configured map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{})
+	for k, v := range flattenStringMap(all) {
+		if _, ok := configured[k]; !ok {
+			if dv, inherited := defaults[k]; inherited && dv == v {
+				continue
+			}
+			if strings.HasPrefix(k, platformMetadataPrefix) {
+				continue
+			}
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// managedMetadata returns the values of the managed keys from the metadata
+// returned by the API. Keys added outside of Terraform are left out, keys
+// removed outside of it show as drift.
+func managedMetadata(all, managed map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{})
+	for k, v := range flattenStringMap(all) {
+		if _, ok := managed[k]; ok {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// customizeDiffMetadataAll plans metadata_all as the provider default metadata
+// merged with metadata, so changing the defaults updates the object. Keys set
+// by the platform are carried over as is.
+func customizeDiffMetadataAll(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("metadata") {
+		return d.SetNewComputed("metadata_all")
+	}
+
+	o := d.Get("metadata_all").(map[string]interface{})
+	n := mergeMetadata(defaultMetadata(m.(meroxa.Client)), d.Get("metadata").(map[string]interface{}))
+	for k, v := range o {
+		if strings.HasPrefix(k, platformMetadataPrefix) {
+			n[k] = v
+		}
+	}
+
+	// A new object has no metadata_all yet, Read fills it in
+	if d.Id() == "" || reflect.DeepEqual(o, n) {
+		return nil
+	}
+	return d.SetNew("metadata_all", n)
+}
+
+// customizeDiffConnectorMetadataAll plans metadata_all of connectors the same
+// way. The API cannot update connector metadata, so a change of the provider
+// default metadata replaces the connector.
+func customizeDiffConnectorMetadataAll(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := customizeDiffMetadataAll(ctx, d, m); err != nil {
+		return err
+	}
+	if d.Id() != "" && d.HasChange("metadata_all") {
+		return d.ForceNew("metadata_all")
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package meroxa
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConfiguredMetadata(t *testing.T) {
+	all := map[string]interface{}{
+		"owner":            "platform",
+		"team":             "data",
+		"cost-center":      "42",
+		"mx:connectorType": "source",
+	}
+	defaults := map[string]interface{}{"owner": "platform", "team": "core", "cost-center": "42"}
+	configured := map[string]interface{}{"team": "data", "cost-center": "42"}
+
+	// owner is inherited and the platform key is not configured, team
+	// overrides its default and cost-center is configured with the default value
+	want := map[string]interface{}{"team": "data", "cost-center": "42"}
+	if got := configuredMetadata(all, defaults, configured); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -3,12 +3,14 @@ package meroxa
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/meroxa/meroxa-go/pkg/meroxa"
 	"golang.org/x/oauth2"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -78,18 +80,93 @@ func Provider(version string) func() *schema.Provider {
 					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("MEROXA_API_URL", nil),
 				},
+				"max_retries": {
+					Type: schema.TypeInt,
+					Description: "Number of times an idempotent API request failing with a transient error is retried. " +
+						"Defaults to `3`.",
+					Optional:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("MEROXA_MAX_RETRIES", defaultMaxRetries),
+					ValidateDiagFunc: validateMaxRetries(),
+				},
+				"max_backoff": {
+					Type:             schema.TypeString,
+					Description:      "Longest wait between two retries of an API request, e.g. `1m`. Defaults to `30s`.",
+					Optional:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("MEROXA_MAX_BACKOFF", defaultMaxBackoff.String()),
+					ValidateDiagFunc: validateMaxBackoff(),
+				},
+				"requests_per_second": {
+					Type:             schema.TypeFloat,
+					Description:      "Maximum rate of API requests, shared by all resources and data sources. Unlimited when unset.",
+					Optional:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("MEROXA_REQUESTS_PER_SECOND", 0),
+					ValidateDiagFunc: validateRequestsPerSecond(),
+				},
+				"max_concurrent_requests": {
+					Type:             schema.TypeInt,
+					Description:      "Maximum number of API requests in flight at once. Unlimited when unset.",
+					Optional:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("MEROXA_MAX_CONCURRENT_REQUESTS", 0),
+					ValidateDiagFunc: validateMaxConcurrentRequests(),
+				},
+				"default_metadata": {
+					Type: schema.TypeList,
+					Description: "Metadata added to every resource and connector. Metadata set on the object itself wins. " +
+						"Changing it replaces existing connectors, the API cannot update their metadata.",
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metadata": {
+								Type:        schema.TypeMap,
+								Description: "Default metadata key/value pairs",
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"poll_interval": {
+					Type: schema.TypeString,
+					Description: "Wait between two polls of an object's state while waiting for it to settle, " +
+						"e.g. `5s`. Defaults to `30s`.",
+					Optional:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("MEROXA_POLL_INTERVAL", defaultPollInterval.String()),
+					ValidateDiagFunc: validatePollInterval(),
+				},
+				"poll_backoff": {
+					Type: schema.TypeBool,
+					Description: "Poll with an exponential backoff starting at 100ms and capped at 10s " +
+						"instead of every `poll_interval`.",
+					Optional: true,
+					DefaultFunc: func() (interface{}, error) {
+						v := os.Getenv("MEROXA_POLL_BACKOFF")
+						return v == "1" || v == "true" || v == "on", nil
+					},
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"meroxa_connector": resourceConnector(),
-				"meroxa_pipeline":  resourcePipeline(),
-				"meroxa_resource":  resourceResource(),
+				"meroxa_connector":   resourceConnector(),
+				"meroxa_endpoint":    resourceEndpoint(),
+				"meroxa_environment": resourceEnvironment(),
+				"meroxa_pipeline":    resourcePipeline(),
+				"meroxa_resource":    resourceResource(),
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"meroxa_connector":      dataSourceConnector(),
+				"meroxa_connector_logs": dataSourceConnectorLogs(),
+				"meroxa_connectors":     dataSourceConnectors(),
+				"meroxa_endpoint":       dataSourceEndpoint(),
+				"meroxa_endpoints":      dataSourceEndpoints(),
+				"meroxa_environment":    dataSourceEnvironment(),
+				"meroxa_environments":   dataSourceEnvironments(),
 				"meroxa_pipeline":       dataSourcePipeline(),
+				"meroxa_pipelines":      dataSourcePipelines(),
 				"meroxa_resource_types": dataSourceResourceTypes(),
 				"meroxa_resource":       dataSourceResource(),
+				"meroxa_resources":      dataSourceResources(),
 				"meroxa_transforms":     dataSourceTransforms(),
+				"meroxa_user":           dataSourceUser(),
 			},
 		}
 		p.ConfigureContextFunc = configure(version)
@@ -106,21 +183,32 @@ func configure(version string) func(context.Context, *schema.ResourceData) (inte
 		// Warning or errors can be collected in a slice type
 		var diags diag.Diagnostics
 
+		maxBackoff, err := time.ParseDuration(d.Get("max_backoff").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// Every attempt of a retried request waits for the limiter, then gets
+		// its own timeout. The client itself has none so retries are not cut
+		// short.
+		var transport http.RoundTripper = &timeoutTransport{
+			next:    http.DefaultTransport,
+			timeout: time.Second * time.Duration(d.Get("timeout").(int)),
+		}
+		transport = newLimitTransport(transport, d.Get("requests_per_second").(float64), d.Get("max_concurrent_requests").(int))
+		transport = newRetryTransport(transport, d.Get("max_retries").(int), maxBackoff)
+		httpClient := &http.Client{Transport: transport}
+
+		// WithClient needs to be added first as it replaces the http client
+		// other options build upon
 		options := []meroxa.Option{
+			meroxa.WithClient(httpClient),
 			meroxa.WithUserAgent(fmt.Sprintf("Meroxa Terraform Provider %s", version)),
 		}
-		debug := d.Get("debug")
-		if debug != "" {
+		if d.Get("debug").(bool) {
 			options = append(options, meroxa.WithDumpTransport(os.Stdout))
 		}
 
-		timeoutInt := d.Get("timeout")
-
-		if timeoutInt != "" {
-			timeout := int64(timeoutInt.(int))
-			options = append(options, meroxa.WithClientTimeout(time.Second*time.Duration(timeout)))
-		}
-
 		apiURL := d.Get("api_url")
 		if apiURL != "" {
 			options = append(options, meroxa.WithBaseURL(apiURL.(string)))
@@ -150,6 +238,103 @@ func configure(version string) func(context.Context, *schema.ResourceData) (inte
 			return nil, diag.FromErr(err)
 		}
 
-		return c, diags
+		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		var defaults map[string]interface{}
+		if v, ok := d.Get("default_metadata.0.metadata").(map[string]interface{}); ok && len(v) > 0 {
+			defaults = v
+		}
+
+		return &providerClient{
+			Client:          c,
+			pollInterval:    pollInterval,
+			pollBackoff:     d.Get("poll_backoff").(bool),
+			defaultMetadata: defaults,
+		}, diags
+	}
+}
+
+func validatePollInterval() schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		interval, err := time.ParseDuration(val.(string))
+		if err != nil || interval <= 0 || interval >= maxPollInterval {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid poll interval",
+				Detail:        fmt.Sprintf("poll interval should be a positive duration below %s, e.g. \"10s\"", maxPollInterval),
+				AttributePath: path,
+			})
+		}
+		return diags
+	}
+}
+
+func validateMaxRetries() schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if val.(int) < 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid max retries",
+				Detail:        "max retries should be zero or a positive number",
+				AttributePath: path,
+			})
+		}
+		return diags
+	}
+}
+
+func validateMaxBackoff() schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		backoff, err := time.ParseDuration(val.(string))
+		if err != nil || backoff < minBackoff {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid max backoff",
+				Detail:        fmt.Sprintf("max backoff should be a duration of at least %s, e.g. \"1m\"", minBackoff),
+				AttributePath: path,
+			})
+		}
+		return diags
+	}
+}
+
+func validateRequestsPerSecond() schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if val.(float64) < 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid requests per second",
+				Detail:        "requests per second should be a positive number, or zero for no limit",
+				AttributePath: path,
+			})
+		}
+		return diags
+	}
+}
+
+func validateMaxConcurrentRequests() schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if val.(int) < 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid max concurrent requests",
+				Detail:        "max concurrent requests should be a positive number, or zero for no limit",
+				AttributePath: path,
+			})
+		}
+		return diags
 	}
 }
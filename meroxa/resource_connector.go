@@ -2,6 +2,8 @@ package meroxa
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -15,19 +17,32 @@ import (
 	"github.com/meroxa/meroxa-go/pkg/meroxa"
 )
 
+// sensitiveConfigHashPrefix marks sensitive_config values hashed in the state.
+const sensitiveConfigHashPrefix = "sha256:"
+
 const (
 	connectorNameMin int = 3
 	connectorNameMax int = 64
+
+	connectorDesiredStateRunning = "running"
+	connectorDesiredStatePaused  = "paused"
 )
 
 var connectorNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*[a-zA-Z0-9]$`)
 
 func resourceConnector() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
+		Description: "Manages a pipeline connector.\n\n" +
+			"~> **Note:** The API cannot update connector metadata, changing a key of `metadata` " +
+			"or the provider `default_metadata` replaces the connector.",
 		CreateContext: resourceConnectorCreate,
 		ReadContext:   resourceConnectorRead,
 		UpdateContext: resourceConnectorUpdate,
 		DeleteContext: resourceConnectorDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -75,12 +90,52 @@ func resourceConnector() *schema.Resource {
 				Computed:    true,
 				Description: "Connector state",
 			},
+			"desired_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     connectorDesiredStateRunning,
+				Description: "Desired connector run state. Must be one of `running` or `paused`.",
+				ValidateDiagFunc: validateStringInSlice("connector desired state", []string{
+					connectorDesiredStateRunning,
+					connectorDesiredStatePaused,
+				}),
+			},
+			"restart_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, will restart the connector",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"config": {
 				Type:        schema.TypeMap,
 				Description: "Connector configuration",
 				Optional:    true,
 				Elem:        schema.TypeString,
 			},
+			"metadata": {
+				Type: schema.TypeMap,
+				Description: "Connector metadata. The API cannot update it, so changing it replaces the connector. " +
+					"Only the keys set here are managed, keys added outside of Terraform are ignored.",
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metadata_all": {
+				Type: schema.TypeMap,
+				Description: "Connector metadata including the provider `default_metadata`. " +
+					"Changing the provider `default_metadata` replaces the connector.",
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sensitive_config": {
+				Type: schema.TypeMap,
+				Description: "Connector configuration holding secrets, merged into `config`. Values are hidden from plans " +
+					"and only their hashes are kept in the state.",
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressSensitiveConfigDiff,
+			},
 			"pipeline_id": {
 				Type:        schema.TypeInt,
 				Description: "Connector's Pipeline ID",
@@ -103,10 +158,16 @@ func resourceConnector() *schema.Resource {
 				Description: "The resource ID for a destination connector",
 			},
 		},
+		CustomizeDiff: customizeDiffConnectorMetadataAll,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceConnectorImport,
 		},
 	}
+
+	for k, v := range connectorConfigBlockSchemas() {
+		r.Schema[k] = v
+	}
+	return r
 }
 
 func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -120,6 +181,7 @@ func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m inte
 		Name:          d.Get("name").(string),
 		ResourceID:    resourceID,
 		Configuration: resourceConnectorConfig(d),
+		Metadata:      mergeMetadata(defaultMetadata(c), d.Get("metadata").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("pipeline_id"); ok {
@@ -151,13 +213,17 @@ func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	input.ResourceID = resourceID
+	if diags = checkConnectorConfigBlockResource(ctx, c, d, resourceID); diags.HasError() {
+		return diags
+	}
 
-	conn, err := c.CreateConnector(ctx, input)
+	conn, err := createConnector(ctx, c, input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(conn.ID))
+	_ = d.Set("sensitive_config", hashSensitiveConfig(resourceConnectorSensitiveConfig(d)))
 
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -166,19 +232,23 @@ func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m inte
 		Target: []string{
 			string(meroxa.ConnectorStateRunning),
 		},
-		Refresh:    resourceConnectorStateFunc(ctx, c, conn.ID),
-		Timeout:    10 * time.Minute,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Refresh: resourceConnectorStateFunc(ctx, c, conn.ID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, c, createStateConf)
 	if err != nil {
 		return diag.FromErr(
 			fmt.Errorf("error waiting for connector (%s) to be created: %s", d.Id(), err),
 		)
 	}
 
+	if d.Get("desired_state").(string) == connectorDesiredStatePaused {
+		if err = resourceConnectorUpdateStatus(ctx, c, conn.ID, meroxa.ActionPause, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resourceConnectorRead(ctx, d, m)
 
 	return diags
@@ -198,11 +268,24 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	conn, err := c.GetConnectorByNameOrID(ctx, fmt.Sprint(id))
 	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return append(diags, notFoundWarning("Connector", cID))
+		}
 		return diag.FromErr(err)
 	}
 
 	_ = d.Set("type", string(conn.Type))
 	_ = d.Set("name", conn.Name)
+	// The API may not return the input, it is only guessed when missing from
+	// the state, e.g. after an import. input forces a new connector.
+	input, resourceID := connectorInputResource(conn)
+	if input != "" && d.Get("input").(string) == "" {
+		_ = d.Set("input", input)
+	}
+	if resourceID != "" && conn.Type == meroxa.ConnectorTypeSource && d.Get("source_id").(string) == "" {
+		_ = d.Set("source_id", resourceID)
+	}
 
 	err = d.Set("streams", flattenStreams(conn))
 	if err != nil {
@@ -211,6 +294,18 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("state", string(conn.State))
 	_ = d.Set("pipeline_id", conn.PipelineID)
 	_ = d.Set("pipeline_name", conn.PipelineName)
+	// Changing metadata replaces the connector, so only the managed keys are
+	// checked for drift
+	_ = d.Set("metadata", managedMetadata(conn.Metadata, d.Get("metadata").(map[string]interface{})))
+	_ = d.Set("metadata_all", flattenStringMap(conn.Metadata))
+
+	// Reflect connectors paused or resumed outside of Terraform in the plan
+	switch conn.State {
+	case meroxa.ConnectorStatePaused:
+		_ = d.Set("desired_state", connectorDesiredStatePaused)
+	case meroxa.ConnectorStateRunning:
+		_ = d.Set("desired_state", connectorDesiredStateRunning)
+	}
 
 	// N.B. Configuration is write-only attribute where the platform API
 	//      returns empty map. Configuration is persisted in the state only.
@@ -224,24 +319,36 @@ func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	var diags diag.Diagnostics
 	c := m.(meroxa.Client)
 
-	name := d.Get("name").(string)
-	if d.HasChange("state") {
-		state := d.Get("state").(string)
-		if _, err := c.UpdateConnectorStatus(ctx, name, meroxa.Action(state)); err != nil {
-			return diag.FromErr(err)
-		}
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if d.HasChange("config") {
+	name := d.Get("name").(string)
+	if d.HasChanges(append(connectorConfigBlockNames(), "config", "sensitive_config")...) {
 		input := &meroxa.UpdateConnectorInput{
 			Configuration: resourceConnectorConfig(d),
 		}
-		if _, err := c.UpdateConnector(ctx, name, input); err != nil {
+		if _, err = c.UpdateConnector(ctx, name, input); err != nil {
 			return diag.FromErr(err)
 		}
+		_ = d.Set("sensitive_config", hashSensitiveConfig(resourceConnectorSensitiveConfig(d)))
 	}
 
-	resourceResourceRead(ctx, d, m)
+	desiredState := d.Get("desired_state").(string)
+	switch {
+	case d.HasChange("desired_state") && desiredState == connectorDesiredStatePaused:
+		err = resourceConnectorUpdateStatus(ctx, c, id, meroxa.ActionPause, d.Timeout(schema.TimeoutUpdate))
+	case d.HasChange("desired_state"):
+		err = resourceConnectorUpdateStatus(ctx, c, id, meroxa.ActionResume, d.Timeout(schema.TimeoutUpdate))
+	case d.HasChange("restart_triggers") && desiredState == connectorDesiredStateRunning:
+		err = resourceConnectorUpdateStatus(ctx, c, id, meroxa.ActionRestart, d.Timeout(schema.TimeoutUpdate))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceConnectorRead(ctx, d, m)
 
 	return diags
 }
@@ -257,13 +364,42 @@ func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	err = c.DeleteConnector(ctx, fmt.Sprint(id))
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return diags
 }
 
+// resourceConnectorImport accepts a connector ID, a connector name or
+// `pipeline-name/connector-name` and sets the numeric ID the other functions
+// expect.
+func resourceConnectorImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(meroxa.Client)
+
+	pipelineName, nameOrID := "", d.Id()
+	if i := strings.Index(nameOrID, "/"); i >= 0 {
+		pipelineName, nameOrID = nameOrID[:i], nameOrID[i+1:]
+		if pipelineName == "" || nameOrID == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected pipeline-name/connector-name", d.Id())
+		}
+	}
+
+	conn, err := c.GetConnectorByNameOrID(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineName != "" && conn.PipelineName != pipelineName {
+		return nil, fmt.Errorf("connector (%s) belongs to pipeline (%s), not (%s)", nameOrID, conn.PipelineName, pipelineName)
+	}
+	d.SetId(strconv.Itoa(conn.ID))
+	// Nothing is managed yet, take over the keys not inherited or set by the
+	// platform
+	_ = d.Set("metadata", configuredMetadata(conn.Metadata, defaultMetadata(c), nil))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceConnectorStateFunc(ctx context.Context, c meroxa.Client, id int) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := c.GetConnectorByNameOrID(ctx, fmt.Sprint(id))
@@ -275,16 +411,86 @@ func resourceConnectorStateFunc(ctx context.Context, c meroxa.Client, id int) re
 	}
 }
 
+// resourceConnectorUpdateStatus performs the given action on the connector
+// and waits until the API reports the resulting state.
+func resourceConnectorUpdateStatus(ctx context.Context, c meroxa.Client, id int, action meroxa.Action, timeout time.Duration) error {
+	pending := []string{
+		string(meroxa.ConnectorStatePending),
+		string(meroxa.ConnectorStatePaused),
+	}
+	target := []string{
+		string(meroxa.ConnectorStateRunning),
+	}
+
+	if action == meroxa.ActionPause {
+		pending = []string{
+			string(meroxa.ConnectorStatePending),
+			string(meroxa.ConnectorStateRunning),
+		}
+		target = []string{
+			string(meroxa.ConnectorStatePaused),
+		}
+	}
+
+	if _, err := c.UpdateConnectorStatus(ctx, fmt.Sprint(id), action); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: resourceConnectorStateFunc(ctx, c, id),
+		Timeout: timeout,
+	}
+
+	if _, err := waitForState(ctx, c, stateConf); err != nil {
+		return fmt.Errorf("error waiting for connector (%d) to %s: %s", id, action, err)
+	}
+	return nil
+}
+
 func flattenStreams(conn *meroxa.Connector) []interface{} {
 	s := make(map[string]interface{})
-	s["dynamic"] = conn.Streams["dynamic"].(bool)
+	s["dynamic"], _ = conn.Streams["dynamic"].(bool)
 	s["output"] = conn.Streams["output"]
 	s["input"] = conn.Streams["input"]
 	return []interface{}{s}
 }
 
+// connectorStreamPattern matches the streams source connectors write to, which
+// are named after the resource and the input they read from.
+var connectorStreamPattern = regexp.MustCompile(`^resource-(\d+)-[^.]*\.(.+)$`)
+
+// connectorInputResource returns the input of the connector and the ID of the
+// resource it reads from, empty when the connector does not tell. The resource
+// a destination connector writes to is not part of the response.
+func connectorInputResource(conn *meroxa.Connector) (input, resourceID string) {
+	input, _ = conn.Configuration["input"].(string)
+
+	switch conn.Type {
+	case meroxa.ConnectorTypeSource:
+		output, _ := conn.Streams["output"].([]interface{})
+		if len(output) == 0 {
+			break
+		}
+		stream, _ := output[0].(string)
+		if m := connectorStreamPattern.FindStringSubmatch(stream); m != nil {
+			resourceID = m[1]
+			if input == "" {
+				input = m[2]
+			}
+		}
+	case meroxa.ConnectorTypeDestination:
+		if streams, _ := conn.Streams["input"].([]interface{}); input == "" && len(streams) == 1 {
+			input, _ = streams[0].(string)
+		}
+	}
+	return input, resourceID
+}
+
 func resourceConnectorConfig(d *schema.ResourceData) map[string]interface{} {
-	config := make(map[string]interface{})
+	// The raw config map overrides the typed blocks
+	config := expandConnectorConfigBlocks(d)
 
 	if v, ok := d.GetOk("config"); ok {
 		for k, v := range v.(map[string]interface{}) {
@@ -292,9 +498,62 @@ func resourceConnectorConfig(d *schema.ResourceData) map[string]interface{} {
 		}
 	}
 
+	for k, v := range resourceConnectorSensitiveConfig(d) {
+		config[k] = v
+	}
+
 	return config
 }
 
+// resourceConnectorSensitiveConfig returns the sensitive_config values as
+// configured. The state only holds their hashes, so the values are read from
+// the raw configuration when Terraform sends it.
+func resourceConnectorSensitiveConfig(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		for k, v := range d.Get("sensitive_config").(map[string]interface{}) {
+			config[k] = v
+		}
+		return config
+	}
+
+	v := raw.GetAttr("sensitive_config")
+	if v.IsNull() || !v.IsKnown() {
+		return config
+	}
+	for k, e := range v.AsValueMap() {
+		if e.IsKnown() && !e.IsNull() {
+			config[k] = e.AsString()
+		}
+	}
+	return config
+}
+
+// hashSensitiveConfig replaces each value with its hash, as kept in the state.
+func hashSensitiveConfig(config map[string]interface{}) map[string]interface{} {
+	hashed := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		hashed[k] = hashSensitiveValue(v.(string))
+	}
+	return hashed
+}
+
+func hashSensitiveValue(v string) string {
+	sum := sha256.Sum256([]byte(v))
+	return sensitiveConfigHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// suppressSensitiveConfigDiff compares a configured sensitive_config value to
+// the hash in the state, so only secrets which changed show in the plan.
+func suppressSensitiveConfigDiff(k, old, new string, _ *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+	return old != "" && old == hashSensitiveValue(new)
+}
+
 func validateConnectorName() schema.SchemaValidateDiagFunc {
 	return func(val interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
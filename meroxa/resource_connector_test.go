@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/meroxa/meroxa-go/pkg/meroxa"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -47,6 +49,12 @@ func TestAccMeroxaConnector_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("meroxa_connector.basic", "state", "running"),
 				),
 			},
+			{
+				ResourceName:      "meroxa_connector.basic",
+				ImportState:       true,
+				ImportStateId:     "connector-test/connector-basic",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -225,6 +233,61 @@ func TestAccMeroxaConnector_WithConfig(t *testing.T) {
 	})
 }
 
+func TestAccMeroxaConnector_desiredState(t *testing.T) {
+	testAccMeroxaConnectorDesiredState := func(state, trigger string) string {
+		return fmt.Sprintf(`
+			resource "meroxa_resource" "connector_test" {
+	  			name = "connector-inline"
+	  			type = "postgres"
+	  			url = "%s"
+			}
+			resource "meroxa_pipeline" "connector_test" {
+	  			name = "connector-test"
+			}
+			resource "meroxa_connector" "desired_state" {
+				name = "connector-desired-state"
+				pipeline_id = meroxa_pipeline.connector_test.id
+				source_id = meroxa_resource.connector_test.id
+				input = "public"
+				desired_state = %q
+				restart_triggers = {
+					schema_version = %q
+				}
+			}
+		`, os.Getenv("MEROXA_POSTGRES_URL"), state, trigger)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMeroxaConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeroxaConnectorDesiredState("paused", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists("meroxa_connector.desired_state"),
+					resource.TestCheckResourceAttr("meroxa_connector.desired_state", "desired_state", "paused"),
+					resource.TestCheckResourceAttr("meroxa_connector.desired_state", "state", "paused"),
+				),
+			},
+			{
+				Config: testAccMeroxaConnectorDesiredState("running", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("meroxa_connector.desired_state", "desired_state", "running"),
+					resource.TestCheckResourceAttr("meroxa_connector.desired_state", "state", "running"),
+				),
+			},
+			{
+				Config: testAccMeroxaConnectorDesiredState("running", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("meroxa_connector.desired_state", "restart_triggers.schema_version", "2"),
+					resource.TestCheckResourceAttr("meroxa_connector.desired_state", "state", "running"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMeroxaConnectorDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(meroxa.Client)
 
@@ -246,3 +309,209 @@ func testAccCheckMeroxaConnectorDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestMeroxaConnector_lifecycle(t *testing.T) {
+	api, meta, d := testConnectorData(t, nil, nil)
+	ctx := context.Background()
+	r := resourceConnector()
+
+	api.script("connectors", "orders-source", "pending", "running")
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	if got := d.Get("state"); got != "running" {
+		t.Errorf("got state %q, want running", got)
+	}
+	if got := d.Get("pipeline_name"); got != "orders" {
+		t.Errorf("got pipeline_name %q, want orders", got)
+	}
+
+	imported := testResourceData(t, r, nil)
+	imported.SetId("orders/orders-source")
+	if _, err := r.Importer.StateContext(ctx, imported, meta); err != nil {
+		t.Fatal(err)
+	}
+	if imported.Id() != d.Id() {
+		t.Errorf("got imported ID %q, want %q", imported.Id(), d.Id())
+	}
+	// The platform may not return the configuration, the input and resource
+	// are read from the output stream then
+	api.mu.Lock()
+	api.findConnector("orders-source").Configuration = nil
+	api.mu.Unlock()
+	requireNoError(t, r.ReadContext(ctx, imported, meta))
+	if got := imported.Get("input"); got != "public.orders" {
+		t.Errorf("got imported input %q, want public.orders", got)
+	}
+	if got, want := imported.Get("source_id"), d.Get("source_id"); got != want {
+		t.Errorf("got imported source_id %q, want %q", got, want)
+	}
+
+	imported.SetId("billing/orders-source")
+	if _, err := r.Importer.StateContext(ctx, imported, meta); err == nil {
+		t.Error("expected an error importing the connector from another pipeline")
+	}
+
+	requireNoError(t, r.DeleteContext(ctx, d, meta))
+	if n := len(api.connectors); n != 0 {
+		t.Errorf("got %d connectors left, want 0", n)
+	}
+}
+
+func TestMeroxaConnector_readKeepsInput(t *testing.T) {
+	api, meta, d := testConnectorData(t, nil, map[string]interface{}{"input": "public.orders,public.users"})
+	ctx := context.Background()
+	r := resourceConnector()
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	// The platform names the output stream after the first table only and
+	// does not return the configuration
+	api.mu.Lock()
+	conn := api.findConnector("orders-source")
+	conn.Configuration = nil
+	conn.Streams["output"] = []interface{}{fmt.Sprintf("resource-%s-orders-source.public.orders", d.Get("source_id"))}
+	api.mu.Unlock()
+
+	requireNoError(t, r.ReadContext(ctx, d, meta))
+	if got := d.Get("input"); got != "public.orders,public.users" {
+		t.Errorf("got input %q, want the configured input kept", got)
+	}
+}
+
+func TestMeroxaConnector_defaultMetadata(t *testing.T) {
+	_, meta, d := testConnectorData(t, map[string]interface{}{
+		"default_metadata": []interface{}{
+			map[string]interface{}{"metadata": map[string]interface{}{"owner": "platform"}},
+		},
+	}, nil)
+	requireNoError(t, resourceConnector().CreateContext(context.Background(), d, meta))
+
+	want := map[string]interface{}{"owner": "platform", "mx:connectorType": "source"}
+	if got := d.Get("metadata_all").(map[string]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got metadata_all %v, want %v", got, want)
+	}
+}
+
+func TestMeroxaConnector_defaultMetadataChange(t *testing.T) {
+	defaults := func(owner string) map[string]interface{} {
+		return map[string]interface{}{
+			"default_metadata": []interface{}{
+				map[string]interface{}{"metadata": map[string]interface{}{"owner": owner}},
+			},
+		}
+	}
+	api, meta, d := testConnectorData(t, defaults("platform"), nil)
+	ctx := context.Background()
+	r := resourceConnector()
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "orders-source",
+		"source_id":   d.Get("source_id"),
+		"pipeline_id": d.Get("pipeline_id"),
+		"input":       "public.orders",
+	})
+	if diff, err := r.Diff(ctx, d.State(), config, meta); err != nil || diff != nil && !diff.Empty() {
+		t.Fatalf("got diff %v, %v, want none with unchanged defaults", diff, err)
+	}
+
+	// The API cannot update connector metadata
+	diff, err := r.Diff(ctx, d.State(), config, api.metaWith(defaults("data")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := diff.Attributes["metadata_all.owner"]; a == nil || a.New != "data" || !diff.RequiresNew() {
+		t.Errorf("got diff %v, want metadata_all.owner replaced with data", diff)
+	}
+}
+
+func TestMeroxaConnector_metadataDrift(t *testing.T) {
+	api, meta, d := testConnectorData(t, nil, map[string]interface{}{
+		"metadata": map[string]interface{}{"owner": "data"},
+	})
+	ctx := context.Background()
+	r := resourceConnector()
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	// The platform connector type key stays out of metadata
+	if got := d.Get("metadata").(map[string]interface{}); !reflect.DeepEqual(got, map[string]interface{}{"owner": "data"}) {
+		t.Errorf("got metadata %v, want only the configured keys", got)
+	}
+
+	api.findConnector("orders-source").Metadata["owner"] = "someone-else"
+	api.findConnector("orders-source").Metadata["runbook"] = "https://example.com"
+	requireNoError(t, r.ReadContext(ctx, d, meta))
+
+	// Keys added outside of Terraform would replace the connector, they are
+	// not managed
+	want := map[string]interface{}{"owner": "someone-else"}
+	if got := d.Get("metadata").(map[string]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got metadata %v, want the drifted %v", got, want)
+	}
+
+	imported := testResourceData(t, r, nil)
+	imported.SetId(d.Id())
+	if _, err := r.Importer.StateContext(ctx, imported, meta); err != nil {
+		t.Fatal(err)
+	}
+	requireNoError(t, r.ReadContext(ctx, imported, meta))
+	want = map[string]interface{}{"owner": "someone-else", "runbook": "https://example.com"}
+	if got := imported.Get("metadata").(map[string]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got imported metadata %v, want %v", got, want)
+	}
+}
+
+func TestMeroxaConnector_sensitiveConfig(t *testing.T) {
+	api, meta, d := testConnectorData(t, nil, map[string]interface{}{
+		"config":           map[string]interface{}{"snapshot": "true"},
+		"sensitive_config": map[string]interface{}{"password": "s3cr3t"},
+	})
+	requireNoError(t, resourceConnector().CreateContext(context.Background(), d, meta))
+
+	config := api.findConnector("orders-source").Configuration
+	if config["password"] != "s3cr3t" || config["snapshot"] != "true" {
+		t.Errorf("got API config %v, want config merged with sensitive_config", config)
+	}
+
+	hash := d.Get("sensitive_config.password").(string)
+	if hash == "s3cr3t" || !strings.HasPrefix(hash, sensitiveConfigHashPrefix) {
+		t.Fatalf("got %q in the state, want a hash", hash)
+	}
+
+	if !suppressSensitiveConfigDiff("sensitive_config.password", hash, "s3cr3t", d) {
+		t.Error("expected no diff for an unchanged secret")
+	}
+	if suppressSensitiveConfigDiff("sensitive_config.password", hash, "rotated", d) {
+		t.Error("expected a diff for a changed secret")
+	}
+}
+
+// testConnectorData seeds the fake API with an orders pipeline and a postgres
+// resource, and returns the data of a source connector reading public.orders
+// from them. providerConfig adds to the provider configuration, raw to the
+// connector arguments.
+func testConnectorData(t *testing.T, providerConfig, raw map[string]interface{}) (*fakeAPI, interface{}, *schema.ResourceData) {
+	t.Helper()
+	api := newFakeAPI(t)
+	meta := api.metaWith(providerConfig)
+	ctx := context.Background()
+
+	pipeline := testResourceData(t, resourcePipeline(), map[string]interface{}{"name": "orders"})
+	requireNoError(t, resourcePipeline().CreateContext(ctx, pipeline, meta))
+	source := testResourceData(t, resourceResource(), map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"url":  "postgres://pg.example.com:5432/db",
+	})
+	requireNoError(t, resourceResource().CreateContext(ctx, source, meta))
+
+	connector := map[string]interface{}{
+		"name":        "orders-source",
+		"source_id":   source.Id(),
+		"pipeline_id": pipeline.Id(),
+		"input":       "public.orders",
+	}
+	for k, v := range raw {
+		connector[k] = v
+	}
+	return api, meta, testResourceData(t, resourceConnector(), connector)
+}
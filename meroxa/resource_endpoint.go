@@ -0,0 +1,206 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+const (
+	endpointStatePending = "pending"
+	endpointStateReady   = "ready"
+)
+
+var endpointProtocols = []string{
+	string(meroxa.EndpointProtocolHttp),
+	string(meroxa.EndpointProtocolGrpc),
+}
+
+func resourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEndpointCreate,
+		ReadContext:   resourceEndpointRead,
+		DeleteContext: resourceEndpointDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Endpoint name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"protocol": {
+				Type:             schema.TypeString,
+				Description:      "Endpoint protocol. Must be one of `HTTP` or `GRPC`.",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringInSlice("endpoint protocol", endpointProtocols),
+			},
+			"stream": {
+				Type:        schema.TypeString,
+				Description: "Stream the endpoint reads from, typically a connector's output stream",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Endpoint host",
+				Computed:    true,
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Description: "Whether the endpoint is ready to accept connections",
+				Computed:    true,
+			},
+			"basic_auth_username": {
+				Type:        schema.TypeString,
+				Description: "Endpoint basic auth username",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"basic_auth_password": {
+				Type:        schema.TypeString,
+				Description: "Endpoint basic auth password",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"url": {
+				Type: schema.TypeString,
+				Description: "Endpoint URL including the basic auth credentials for `HTTP` endpoints, " +
+					"the endpoint host for `GRPC` endpoints",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	input := &meroxa.CreateEndpointInput{
+		Name:     d.Get("name").(string),
+		Protocol: meroxa.EndpointProtocol(d.Get("protocol").(string)),
+		Stream:   d.Get("stream").(string),
+	}
+
+	err := c.CreateEndpoint(ctx, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(input.Name)
+
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			endpointStatePending,
+		},
+		Target: []string{
+			endpointStateReady,
+		},
+		Refresh: resourceEndpointStateFunc(ctx, c, input.Name),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	_, err = waitForState(ctx, c, createStateConf)
+	if err != nil {
+		return diag.FromErr(
+			fmt.Errorf("error waiting for endpoint (%s) to be ready: %s", d.Id(), err),
+		)
+	}
+
+	resourceEndpointRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	end, err := c.GetEndpoint(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			diags = append(diags, notFoundWarning("Endpoint", d.Id()))
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", end.Name)
+	_ = d.Set("protocol", string(end.Protocol))
+	_ = d.Set("stream", end.Stream)
+	_ = d.Set("host", end.Host)
+	_ = d.Set("ready", end.Ready)
+	_ = d.Set("basic_auth_username", end.BasicAuthUsername)
+	_ = d.Set("basic_auth_password", end.BasicAuthPassword)
+	_ = d.Set("url", endpointURL(end))
+
+	return diags
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	err := c.DeleteEndpoint(ctx, d.Id())
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourceEndpointStateFunc(ctx context.Context, c meroxa.Client, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := c.GetEndpoint(ctx, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp.Ready {
+			return resp, endpointStateReady, nil
+		}
+		return resp, endpointStatePending, nil
+	}
+}
+
+// endpointURL returns a ready-to-use address for the endpoint. HTTP endpoints
+// carry their basic auth credentials inline, gRPC clients pass them as
+// request metadata, so only the host is returned for those.
+func endpointURL(end *meroxa.Endpoint) string {
+	if end.Host == "" || end.Protocol != meroxa.EndpointProtocolHttp {
+		return end.Host
+	}
+
+	u := &url.URL{
+		Scheme: "https",
+		Host:   end.Host,
+	}
+	if end.BasicAuthUsername != "" {
+		u.User = url.UserPassword(end.BasicAuthUsername, end.BasicAuthPassword)
+	}
+	return u.String()
+}
@@ -0,0 +1,312 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+var (
+	environmentProviders = []string{
+		string(meroxa.EnvironmentProviderAws),
+	}
+
+	environmentTypes = []string{
+		string(meroxa.EnvironmentTypeHosted),
+		string(meroxa.EnvironmentTypeDedicated),
+		string(meroxa.EnvironmentTypeCommon),
+	}
+
+	environmentRegions = []string{
+		string(meroxa.EnvironmentRegionAfSouth),
+		string(meroxa.EnvironmentRegionApEast),
+		string(meroxa.EnvironmentRegionApNortheast1),
+		string(meroxa.EnvironmentRegionApNortheast2),
+		string(meroxa.EnvironmentRegionApNortheast3),
+		string(meroxa.EnvironmentRegionApSouth),
+		string(meroxa.EnvironmentRegionApSoutheast1),
+		string(meroxa.EnvironmentRegionApSoutheast2),
+		string(meroxa.EnvironmentRegionCaCentral),
+		string(meroxa.EnvironmentRegionEuCentral),
+		string(meroxa.EnvironmentRegionEuNorth),
+		string(meroxa.EnvironmentRegionEuSouth),
+		string(meroxa.EnvironmentRegionEuWest1),
+		string(meroxa.EnvironmentRegionEuWest2),
+		string(meroxa.EnvironmentRegionEuWest3),
+		string(meroxa.EnvironmentRegionMeSouth),
+		string(meroxa.EnvironmentRegionSaEast1),
+		string(meroxa.EnvironmentRegionUsEast1),
+		string(meroxa.EnvironmentRegionUsEast2),
+		string(meroxa.EnvironmentRegionUsWest2),
+	}
+)
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		DeleteContext: resourceEnvironmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Environment name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cloud_provider": {
+				Type:             schema.TypeString,
+				Description:      "Environment cloud provider",
+				Optional:         true,
+				ForceNew:         true,
+				Default:          string(meroxa.EnvironmentProviderAws),
+				ValidateDiagFunc: validateStringInSlice("environment provider", environmentProviders),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Description:      "Environment region",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringInSlice("environment region", environmentRegions),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Description:      "Environment type",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateStringInSlice("environment type", environmentTypes),
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "Environment configuration",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Description: "Environment UUID",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Environment status",
+				Computed:    true,
+			},
+			"status_details": {
+				Type:        schema.TypeString,
+				Description: "Environment status details",
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Environment Created at timestamp",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "Environment Updated at timestamp",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentImport,
+		},
+	}
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	input := &meroxa.CreateEnvironmentInput{
+		Name:          d.Get("name").(string),
+		Provider:      meroxa.EnvironmentProvider(d.Get("cloud_provider").(string)),
+		Region:        meroxa.EnvironmentRegion(d.Get("region").(string)),
+		Type:          meroxa.EnvironmentType(d.Get("type").(string)),
+		Configuration: resourceEnvironmentConfig(d),
+	}
+
+	env, err := c.CreateEnvironment(ctx, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(env.UUID)
+
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(meroxa.EnvironmentStateProvisioning),
+			string(meroxa.EnvironmentStateUpdating),
+			string(meroxa.EnvironmentStateRepairing),
+		},
+		Target: []string{
+			string(meroxa.EnvironmentStateProvisioned),
+		},
+		Refresh: resourceEnvironmentStateFunc(ctx, c, env.UUID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	_, err = waitForState(ctx, c, createStateConf)
+	if err != nil {
+		return diag.FromErr(
+			fmt.Errorf("error waiting for environment (%s) to be provisioned: %s", d.Id(), err),
+		)
+	}
+
+	resourceEnvironmentRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	env, err := c.GetEnvironment(ctx, d.Id())
+	if err != nil {
+		if isNotFound(err) {
+			diags = append(diags, notFoundWarning("Environment", d.Id()))
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("uuid", env.UUID)
+	_ = d.Set("name", env.Name)
+	_ = d.Set("cloud_provider", string(env.Provider))
+	_ = d.Set("region", string(env.Region))
+	_ = d.Set("type", string(env.Type))
+	_ = d.Set("status", string(env.Status.State))
+	_ = d.Set("status_details", env.Status.Details)
+	_ = d.Set("created_at", env.CreatedAt.String())
+	_ = d.Set("updated_at", env.UpdatedAt.String())
+
+	// N.B. Configuration may hold cloud credentials which the platform API
+	//      does not return verbatim. Configuration is persisted in the state only.
+	// _ = d.Set("config", env.Configuration)
+
+	return diags
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	c := m.(meroxa.Client)
+
+	_, err := c.DeleteEnvironment(ctx, d.Id())
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(meroxa.EnvironmentStateProvisioned),
+			string(meroxa.EnvironmentStateDeprovisioning),
+		},
+		Target: []string{
+			string(meroxa.EnvironmentStateDeprovisioned),
+		},
+		Refresh: resourceEnvironmentStateFunc(ctx, c, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	_, err = waitForState(ctx, c, deleteStateConf)
+	if err != nil {
+		return diag.FromErr(
+			fmt.Errorf("error waiting for environment (%s) to be deprovisioned: %s", d.Id(), err),
+		)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceEnvironmentImport accepts either the environment name or its UUID
+// and stores the UUID as the resource ID.
+func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(meroxa.Client)
+
+	env, err := c.GetEnvironment(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(env.UUID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceEnvironmentStateFunc(ctx context.Context, c meroxa.Client, nameOrUUID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := c.GetEnvironment(ctx, nameOrUUID)
+		if isNotFound(err) {
+			// Deprovisioned environments may be purged before the last refresh
+			return &meroxa.Environment{}, string(meroxa.EnvironmentStateDeprovisioned), nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp.Status.State == meroxa.EnvironmentStateError {
+			return resp, string(resp.Status.State), fmt.Errorf("environment is in error state: %s", resp.Status.Details)
+		}
+
+		return resp, string(resp.Status.State), nil
+	}
+}
+
+func resourceEnvironmentConfig(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	if v, ok := d.GetOk("config"); ok {
+		for k, v := range v.(map[string]interface{}) {
+			config[k] = v
+		}
+	}
+
+	return config
+}
+
+func validateStringInSlice(name string, valid []string) schema.SchemaValidateDiagFunc {
+	return func(val interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+		v := val.(string)
+
+		for _, s := range valid {
+			if v == s {
+				return diags
+			}
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid %s", name),
+			Detail:        fmt.Sprintf("%s %q should be one of: %s", name, v, strings.Join(valid, ", ")),
+			AttributePath: path,
+		})
+		return diags
+	}
+}
@@ -0,0 +1,139 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestAccMeroxaEnvironment_basic(t *testing.T) {
+	testAccMeroxaEnvironmentBasic := `
+	resource "meroxa_environment" "basic" {
+	  name   = "environment-basic"
+	  type   = "dedicated"
+	  region = "us-east-1"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMeroxaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeroxaEnvironmentBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists("meroxa_environment.basic"),
+					resource.TestCheckResourceAttr("meroxa_environment.basic", "name", "environment-basic"),
+					resource.TestCheckResourceAttr("meroxa_environment.basic", "type", "dedicated"),
+					resource.TestCheckResourceAttr("meroxa_environment.basic", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("meroxa_environment.basic", "cloud_provider", "aws"),
+					resource.TestCheckResourceAttr("meroxa_environment.basic", "status", "provisioned"),
+				),
+			},
+			{
+				ResourceName:            "meroxa_environment.basic",
+				ImportState:             true,
+				ImportStateId:           "environment-basic",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
+
+func TestAccMeroxaEnvironment_InvalidRegion(t *testing.T) {
+	testAccMeroxaEnvironmentInvalidRegion := `
+	resource "meroxa_environment" "invalid" {
+	  name   = "environment-invalid"
+	  type   = "dedicated"
+	  region = "us-east-9"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMeroxaEnvironmentInvalidRegion,
+				ExpectError: regexp.MustCompile("Invalid environment region"),
+			},
+		},
+	})
+}
+
+func testAccCheckMeroxaEnvironmentDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(meroxa.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "meroxa_environment" {
+			continue
+		}
+
+		env, err := c.GetEnvironment(context.Background(), rs.Primary.ID)
+		if err == nil && env != nil && env.Status.State != meroxa.EnvironmentStateDeprovisioned {
+			return fmt.Errorf("environment still exists")
+		}
+	}
+	return nil
+}
+
+func TestMeroxaEnvironment_lifecycle(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	ctx := context.Background()
+	r := resourceEnvironment()
+
+	api.script("environments", "dedicated", "provisioning", "provisioning", "provisioned")
+	d := testResourceData(t, r, map[string]interface{}{
+		"name":   "dedicated",
+		"type":   "dedicated",
+		"region": "us-east-1",
+	})
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	if got := d.Get("status"); got != "provisioned" {
+		t.Errorf("got status %q, want provisioned", got)
+	}
+
+	api.script("environments", "dedicated", "deprovisioning", "deprovisioned")
+	requireNoError(t, r.DeleteContext(ctx, d, meta))
+}
+
+func TestMeroxaEnvironment_createError(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	r := resourceEnvironment()
+
+	api.script("environments", "dedicated", "provisioning", "error")
+	d := testResourceData(t, r, map[string]interface{}{
+		"name":   "dedicated",
+		"type":   "dedicated",
+		"region": "us-east-1",
+	})
+
+	diags := r.CreateContext(context.Background(), d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "scripted error") {
+		t.Fatalf("got %v, want the environment status details in the error", diags)
+	}
+}
+
+func TestMeroxaEnvironment_deletedOutsideTerraform(t *testing.T) {
+	api := newFakeAPI(t)
+	d := testResourceData(t, resourceEnvironment(), nil)
+	d.SetId("2f6d1a38-0000-4000-8000-000000000000")
+
+	diags := resourceEnvironment().ReadContext(context.Background(), d, api.meta())
+	requireNoError(t, diags)
+	if d.Id() != "" {
+		t.Errorf("got ID %q, want the environment removed from state", d.Id())
+	}
+	if len(diags) != 1 || diags[0].Summary != "Environment (2f6d1a38-0000-4000-8000-000000000000) not found" {
+		t.Errorf("got %v, want a warning naming the environment ID", diags)
+	}
+}
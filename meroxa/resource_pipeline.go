@@ -2,19 +2,34 @@ package meroxa
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/meroxa/meroxa-go/pkg/meroxa"
 )
 
+const (
+	pipelineDesiredStateRunning = "running"
+	pipelineDesiredStatePaused  = "paused"
+
+	// pipelineStatePaused is reported by the API for paused pipelines.
+	pipelineStatePaused meroxa.PipelineState = "paused"
+)
+
 func resourcePipeline() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePipelineCreate,
 		ReadContext:   resourcePipelineRead,
 		UpdateContext: resourcePipelineUpdate,
 		DeleteContext: resourcePipelineDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -26,9 +41,20 @@ func resourcePipeline() *schema.Resource {
 				Description: "Pipeline state",
 				Computed:    true,
 			},
+			"desired_state": {
+				Type:        schema.TypeString,
+				Description: "Desired pipeline run state. Must be one of `running` or `paused`.",
+				Optional:    true,
+				Default:     pipelineDesiredStateRunning,
+				ValidateDiagFunc: validateStringInSlice("pipeline desired state", []string{
+					pipelineDesiredStateRunning,
+					pipelineDesiredStatePaused,
+				}),
+			},
+			"connectors": pipelineConnectorsSchema(),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePipelineImport,
 		},
 	}
 }
@@ -49,6 +75,13 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	d.SetId(strconv.Itoa(p.ID))
+
+	if desired := d.Get("desired_state").(string); desired != pipelineDesiredStateRunning {
+		if err = resourcePipelineUpdateStatus(ctx, c, p.ID, desired, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resourcePipelineRead(ctx, d, m)
 
 	return diags
@@ -60,15 +93,40 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	c := m.(meroxa.Client)
 
-	name := d.Get("name").(string)
+	pID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	p, err := c.GetPipelineByName(ctx, name)
+	p, err := c.GetPipeline(ctx, pID)
 	if err != nil {
+		if isNotFound(err) {
+			diags = append(diags, notFoundWarning("Pipeline", d.Id()))
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
+	_ = d.Set("name", p.Name)
 	_ = d.Set("state", p.State)
 
+	// Reflect pipelines paused or resumed outside of Terraform in the plan
+	if p.State == pipelineStatePaused {
+		_ = d.Set("desired_state", pipelineDesiredStatePaused)
+	} else {
+		_ = d.Set("desired_state", pipelineDesiredStateRunning)
+	}
+
+	conns, err := c.ListPipelineConnectors(ctx, p.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("connectors", flattenPipelineConnectors(conns)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting connectors: %s", err))
+	}
+
 	return diags
 }
 
@@ -87,9 +145,18 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	_, err = c.UpdatePipeline(ctx, pID, input)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("name") {
+		_, err = c.UpdatePipeline(ctx, pID, input)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("desired_state") {
+		err = resourcePipelineUpdateStatus(ctx, c, pID, d.Get("desired_state").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	resourcePipelineRead(ctx, d, m)
@@ -108,9 +175,140 @@ func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	err = c.DeletePipeline(ctx, pID)
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return diags
 }
+
+// resourcePipelineImport accepts a pipeline ID or name and sets the numeric ID
+// the other functions expect.
+func resourcePipelineImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(meroxa.Client)
+
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	p, err := c.GetPipelineByName(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(strconv.Itoa(p.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourcePipelineUpdateStatus pauses or resumes the pipeline and waits until
+// the API reports the desired state.
+func resourcePipelineUpdateStatus(ctx context.Context, c meroxa.Client, id int, desired string, timeout time.Duration) error {
+	action := meroxa.ActionResume
+	pending := []string{string(pipelineStatePaused)}
+	target := []string{string(meroxa.PipelineStateHealthy), string(meroxa.PipelineStateDegraded)}
+
+	if desired == pipelineDesiredStatePaused {
+		action = meroxa.ActionPause
+		pending, target = target, pending
+	}
+
+	if _, err := c.UpdatePipelineStatus(ctx, id, action); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: resourcePipelineStateFunc(ctx, c, id),
+		Timeout: timeout,
+	}
+
+	if _, err := waitForState(ctx, c, stateConf); err != nil {
+		return fmt.Errorf("error waiting for pipeline (%d) to be %s: %s", id, desired, err)
+	}
+	return nil
+}
+
+func resourcePipelineStateFunc(ctx context.Context, c meroxa.Client, id int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := c.GetPipeline(ctx, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return resp, string(resp.State), nil
+	}
+}
+
+func pipelineConnectorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Connectors attached to the pipeline",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Connector ID",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Connector Name",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Connector Type",
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Connector state",
+				},
+				"streams": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Connector Streams",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"dynamic": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"input": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"output": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenPipelineConnectors(connectors []*meroxa.Connector) []interface{} {
+	if connectors != nil {
+		cMap := make([]interface{}, len(connectors))
+		for i, conn := range connectors {
+			ci := make(map[string]interface{})
+			ci["id"] = strconv.Itoa(conn.ID)
+			ci["name"] = conn.Name
+			ci["type"] = string(conn.Type)
+			ci["state"] = string(conn.State)
+			ci["streams"] = flattenStreams(conn)
+
+			cMap[i] = ci
+		}
+		return cMap
+	}
+	return make([]interface{}, 0)
+}
@@ -3,6 +3,7 @@ package meroxa
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -26,12 +27,91 @@ func TestAccMeroxaPipeline_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMeroxaResourceExists("meroxa_pipeline.basic"),
 					resource.TestCheckResourceAttr("meroxa_pipeline.basic", "name", "pipeline-basic"),
+					resource.TestCheckResourceAttr("meroxa_pipeline.basic", "connectors.#", "0"),
 				),
 			},
+			{
+				ResourceName:      "meroxa_pipeline.basic",
+				ImportState:       true,
+				ImportStateId:     "pipeline-basic",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMeroxaPipeline_desiredState(t *testing.T) {
+	testAccMeroxaPipelineDesiredState := func(state string) string {
+		return fmt.Sprintf(`
+		resource "meroxa_pipeline" "desired_state" {
+		  name          = "pipeline-desired-state"
+		  desired_state = %q
+		}
+		`, state)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMeroxaPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeroxaPipelineDesiredState("paused"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists("meroxa_pipeline.desired_state"),
+					resource.TestCheckResourceAttr("meroxa_pipeline.desired_state", "desired_state", "paused"),
+					resource.TestCheckResourceAttr("meroxa_pipeline.desired_state", "state", "paused"),
+				),
+			},
+			{
+				Config: testAccMeroxaPipelineDesiredState("running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists("meroxa_pipeline.desired_state"),
+					resource.TestCheckResourceAttr("meroxa_pipeline.desired_state", "desired_state", "running"),
+					resource.TestCheckResourceAttr("meroxa_pipeline.desired_state", "state", "healthy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMeroxaPipeline_disappears(t *testing.T) {
+	testAccMeroxaPipelineDisappears := `
+	resource "meroxa_pipeline" "disappears" {
+	  name = "pipeline-disappears"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMeroxaPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeroxaPipelineDisappears,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists("meroxa_pipeline.disappears"),
+					testAccCheckMeroxaPipelineDisappears("meroxa_pipeline.disappears"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
 
+// testAccCheckMeroxaPipelineDisappears deletes the pipeline behind Terraform's
+// back, the refresh following the step must plan to create it again.
+func testAccCheckMeroxaPipelineDisappears(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(meroxa.Client)
+
+		id, err := strconv.Atoi(s.RootModule().Resources[n].Primary.ID)
+		if err != nil {
+			return err
+		}
+		return c.DeletePipeline(context.Background(), id)
+	}
+}
+
 func testAccCheckMeroxaPipelineDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(meroxa.Client)
 
@@ -49,3 +129,50 @@ func testAccCheckMeroxaPipelineDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestMeroxaPipeline_lifecycle(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	ctx := context.Background()
+	r := resourcePipeline()
+
+	d := testResourceData(t, r, map[string]interface{}{
+		"name":          "orders",
+		"desired_state": "paused",
+	})
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	if got := d.Get("state"); got != "paused" {
+		t.Errorf("got state %q, want paused", got)
+	}
+
+	imported := testResourceData(t, r, nil)
+	imported.SetId("orders")
+	if _, err := r.Importer.StateContext(ctx, imported, meta); err != nil {
+		t.Fatal(err)
+	}
+	requireNoError(t, r.ReadContext(ctx, imported, meta))
+	if imported.Id() != d.Id() || imported.Get("name") != "orders" {
+		t.Errorf("got imported pipeline %q (%s), want orders (%s)", imported.Get("name"), imported.Id(), d.Id())
+	}
+
+	requireNoError(t, r.DeleteContext(ctx, d, meta))
+	if n := len(api.pipelines); n != 0 {
+		t.Errorf("got %d pipelines left, want 0", n)
+	}
+}
+
+func TestMeroxaPipeline_deletedOutsideTerraform(t *testing.T) {
+	api := newFakeAPI(t)
+	d := testResourceData(t, resourcePipeline(), nil)
+	d.SetId("42")
+
+	diags := resourcePipeline().ReadContext(context.Background(), d, api.meta())
+	requireNoError(t, diags)
+	if d.Id() != "" {
+		t.Errorf("got ID %q, want the pipeline removed from state", d.Id())
+	}
+	if len(diags) != 1 || diags[0].Summary != "Pipeline (42) not found" {
+		t.Errorf("got %v, want a warning naming the pipeline ID", diags)
+	}
+}
@@ -22,6 +22,10 @@ func resourceResource() *schema.Resource {
 		ReadContext:   resourceResourceRead,
 		UpdateContext: resourceResourceUpdate,
 		DeleteContext: resourceResourceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -31,13 +35,15 @@ func resourceResource() *schema.Resource {
 			},
 			"type": {
 				Type:        schema.TypeString,
-				Description: "Resource Type. Must be one of the supported resource types.",
+				Description: "Resource Type. Must be one of the supported resource types, checked at plan time.",
 				Required:    true,
 				ForceNew:    true,
 			},
 			"url": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"url", "connection_details"},
 				Description: "Resource URL. Warning will be thrown if credentials are placed inline." +
 					"Using the credentials block is highly encouraged",
 				ValidateDiagFunc: validateURL(),
@@ -56,12 +62,19 @@ func resourceResource() *schema.Resource {
 					return oClean == nClean
 				},
 			},
+			"connection_details": connectionDetailsSchema(),
 			"metadata": {
 				Type:        schema.TypeMap,
 				Description: "Resource metadata",
 				Optional:    true,
 				Elem:        schema.TypeString,
 			},
+			"metadata_all": {
+				Type:        schema.TypeMap,
+				Description: "Resource metadata including the provider `default_metadata`",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"ssh_tunnel": {
 				Type:        schema.TypeList,
 				Description: "Resource ssh tunnel configuration",
@@ -85,14 +98,40 @@ func resourceResource() *schema.Resource {
 							Description: "SSH public Key",
 							Computed:    true,
 						},
+						"rotate_key_trigger": {
+							Type: schema.TypeString,
+							Description: "Arbitrary value that, when changed, rotates the bastion key pair and " +
+								"refreshes `ssh_tunnel_public_key`",
+							Optional: true,
+						},
 					},
 				},
 			},
+			"ssh_tunnel_public_key": {
+				Type:        schema.TypeString,
+				Description: "SSH tunnel public key to add to the bastion host, known after apply when the key pair is rotated",
+				Computed:    true,
+			},
 			"status": { // todo fix state in API
 				Type:        schema.TypeString,
 				Description: "Resource status",
 				Computed:    true,
 			},
+			"status_details": {
+				Type:        schema.TypeString,
+				Description: "Resource status details, e.g. the reason a resource is in `error`",
+				Computed:    true,
+			},
+			"status_last_updated_at": {
+				Type:        schema.TypeString,
+				Description: "Resource status Last updated at timestamp",
+				Computed:    true,
+			},
+			"revalidate_trigger": {
+				Type:        schema.TypeString,
+				Description: "Arbitrary value that, when changed, asks the platform to validate the resource again",
+				Optional:    true,
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: "Resource Created at timestamp",
@@ -153,9 +192,34 @@ func resourceResource() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceResourceImport,
 		},
+		CustomizeDiff: resourceResourceCustomizeDiff,
+	}
+}
+
+func resourceResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, f := range []schema.CustomizeDiffFunc{
+		customizeDiffMetadataAll,
+		customizeDiffResourceType,
+		customizeDiffConnectionDetails,
+		customizeDiffURL,
+		customizeDiffSSHTunnel,
+	} {
+		if err := f(ctx, d, m); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// customizeDiffSSHTunnel plans a new ssh_tunnel_public_key when
+// rotate_key_trigger changes.
+func customizeDiffSSHTunnel(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("ssh_tunnel.0.rotate_key_trigger") {
+		return nil
+	}
+	return d.SetNewComputed("ssh_tunnel_public_key")
 }
 
 func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -167,13 +231,16 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, m inter
 	input := &meroxa.CreateResourceInput{
 		Type:     meroxa.ResourceType(d.Get("type").(string)),
 		Name:     d.Get("name").(string),
-		URL:      d.Get("url").(string),
-		Metadata: resourceMetadata(d),
+		URL:      resourceResourceURL(d),
+		Metadata: mergeMetadata(defaultMetadata(c), resourceMetadata(d)),
 	}
 
 	if v, ok := d.GetOk("credentials"); ok {
 		input.Credentials = expandCredentials(v.([]interface{}))
 	}
+	if creds := connectionDetailsCredentials(d); creds != nil {
+		input.Credentials = creds
+	}
 
 	if v, ok := d.GetOk("ssh_tunnel"); ok {
 		input.SSHTunnel = expandSSHTunnel(v.([]interface{}))
@@ -185,17 +252,7 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	d.SetId(strconv.Itoa(res.ID))
 	if tun := res.SSHTunnel; tun != nil {
-		detail := fmt.Sprintf(
-			"Resource %q is successfully created but is pending for validation!\n"+
-				"Paste the following public key on your host:\n"+
-				tun.PublicKey+
-				"Meroxa will try to connect to the resource for 60 minutes and send an email confirmation after a "+
-				"successful resource validation.", res.Name)
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Validate SSH Tunnel",
-			Detail:   detail,
-		})
+		diags = append(diags, sshTunnelPublicKeyWarning(res.Name, tun.PublicKey))
 	}
 
 	createStateConf := &resource.StateChangeConf{
@@ -206,13 +263,11 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		Target: []string{
 			string(meroxa.ResourceStateReady),
 		},
-		Refresh:    resourceResourceStateFunc(ctx, c, res.ID),
-		Timeout:    10 * time.Minute,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
+		Refresh: resourceResourceStateFunc(ctx, c, res.ID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	_, err = createStateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, c, createStateConf)
 	if err != nil {
 		return diag.FromErr(
 			fmt.Errorf("error waiting for resource (%s) to be created: %s", d.Id(), err),
@@ -238,26 +293,36 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	r, err := c.GetResourceByNameOrID(ctx, fmt.Sprint(id))
 	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return append(diags, notFoundWarning("Resource", rID))
+		}
 		return diag.FromErr(err)
 	}
 
 	_ = d.Set("name", r.Name)
 	_ = d.Set("type", string(r.Type))
 	_ = d.Set("url", r.URL)
-	_ = d.Set("metadata", r.Metadata)
+	_ = d.Set("metadata", configuredMetadata(r.Metadata, defaultMetadata(c), resourceMetadata(d)))
+	_ = d.Set("metadata_all", flattenStringMap(r.Metadata))
 	_ = d.Set("status", string(r.Status.State))
+	_ = d.Set("status_details", r.Status.Details)
+	_ = d.Set("status_last_updated_at", r.Status.LastUpdatedAt.String())
 	_ = d.Set("created_at", r.CreatedAt.String())
 	_ = d.Set("updated_at", r.UpdatedAt.String())
 
 	sshTunnel := flattenSSHTunnel(r.SSHTunnel)
 	if sshTunnel == nil {
+		_ = d.Set("ssh_tunnel_public_key", "")
 		return diags
 	}
+	_ = d.Set("ssh_tunnel_public_key", r.SSHTunnel.PublicKey)
 
 	if v, ok := d.GetOk("ssh_tunnel"); ok {
 		if vv := expandSSHTunnel(v.([]interface{})); vv != nil {
 			sshTunnel["private_key"] = vv.PrivateKey
 		}
+		sshTunnel["rotate_key_trigger"] = d.Get("ssh_tunnel.0.rotate_key_trigger")
 	}
 
 	if err := d.Set("ssh_tunnel", []interface{}{sshTunnel}); err != nil {
@@ -275,14 +340,20 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	input := &meroxa.UpdateResourceInput{
 		Name:     d.Get("name").(string),
-		URL:      d.Get("url").(string),
-		Metadata: resourceMetadata(d),
+		URL:      resourceResourceURL(d),
+		Metadata: mergeMetadata(defaultMetadata(c), resourceMetadata(d)),
 	}
 
 	if d.HasChange("credentials") {
 		input.Credentials = expandCredentials(d.Get("credentials").([]interface{}))
 	}
-	if d.HasChange("ssh_tunnel") {
+	if d.HasChanges("connection_details.0.username", "connection_details.0.password") {
+		input.Credentials = connectionDetailsCredentials(d)
+		if input.Credentials == nil {
+			input.Credentials = &meroxa.Credentials{}
+		}
+	}
+	if d.HasChanges("ssh_tunnel.0.address", "ssh_tunnel.0.private_key") {
 		input.SSHTunnel = expandSSHTunnel(d.Get("ssh_tunnel").([]interface{}))
 	}
 	log.Printf("[DEBUG] Updating meroxa resource: %v", input)
@@ -290,6 +361,24 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	// Only rotate tunnels that already existed, a new tunnel comes with a fresh key
+	o, n := d.GetChange("ssh_tunnel")
+	if d.HasChange("ssh_tunnel.0.rotate_key_trigger") && len(o.([]interface{})) > 0 && len(n.([]interface{})) > 0 {
+		log.Printf("[DEBUG] Rotating meroxa resource tunnel key: %s", d.Id())
+		res, rotateErr := c.RotateTunnelKeyForResource(ctx, d.Id())
+		if rotateErr != nil {
+			return diag.FromErr(rotateErr)
+		}
+		if tun := res.SSHTunnel; tun != nil {
+			diags = append(diags, sshTunnelRotatedKeyWarning(res.Name, tun.PublicKey))
+		}
+	}
+
+	if d.HasChange("revalidate_trigger") {
+		diags = append(diags, resourceResourceValidate(ctx, c, d.Id(), d.Timeout(schema.TimeoutUpdate))...)
+	}
+
 	resourceResourceRead(ctx, d, m)
 	return diags
 }
@@ -306,7 +395,7 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 	err = c.DeleteResource(ctx, fmt.Sprint(rID))
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -317,6 +406,91 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, m inter
 	return diags
 }
 
+// resourceResourceImport accepts a resource ID or name and sets the numeric ID
+// the other functions expect.
+func resourceResourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(meroxa.Client)
+
+	r, err := c.GetResourceByNameOrID(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(strconv.Itoa(r.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceResourceValidate triggers a validation of the resource and waits for
+// its outcome. A failed validation is reported as a warning since the resource
+// itself was updated successfully.
+func resourceResourceValidate(ctx context.Context, c meroxa.Client, id string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Validating meroxa resource: %s", id)
+	res, err := c.ValidateResource(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rID, err := strconv.Atoi(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	validateStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(meroxa.ResourceStatePending),
+			string(meroxa.ResourceStateStarting),
+		},
+		Target: []string{
+			string(meroxa.ResourceStateReady),
+			string(meroxa.ResourceStateError),
+		},
+		Refresh: resourceResourceValidateStateFunc(ctx, c, rID, res.Status),
+		Timeout: timeout,
+	}
+
+	v, err := waitForState(ctx, c, validateStateConf)
+	if err != nil {
+		return diag.FromErr(
+			fmt.Errorf("error waiting for resource (%s) to be validated: %s", id, err),
+		)
+	}
+
+	if r := v.(*meroxa.Resource); r.Status.State == meroxa.ResourceStateError {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Resource validation failed",
+			Detail:   fmt.Sprintf("Resource %q failed validation: %s", r.Name, r.Status.Details),
+		})
+	}
+	return diags
+}
+
+// resourceResourceValidateStateFunc reports a resource as pending until its
+// status changed after the validation was triggered, an unchanged ready or
+// error state is the outcome of an earlier validation.
+func resourceResourceValidateStateFunc(
+	ctx context.Context, c meroxa.Client, id int, triggered meroxa.ResourceStatus,
+) resource.StateRefreshFunc {
+	refresh := resourceResourceStateFunc(ctx, c, id)
+	started := triggered.State == meroxa.ResourceStatePending || triggered.State == meroxa.ResourceStateStarting
+
+	return func() (interface{}, string, error) {
+		v, state, err := refresh()
+		if err != nil || started {
+			return v, state, err
+		}
+
+		status := v.(*meroxa.Resource).Status
+		if status.State != triggered.State || status.LastUpdatedAt.After(triggered.LastUpdatedAt) {
+			started = true
+			return v, state, nil
+		}
+		return v, string(meroxa.ResourceStatePending), nil
+	}
+}
+
 func resourceResourceStateFunc(ctx context.Context, c meroxa.Client, id int) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := c.GetResourceByNameOrID(ctx, fmt.Sprint(id))
@@ -402,6 +576,33 @@ func expandSSHTunnel(vSSHTunnel []interface{}) *meroxa.ResourceSSHTunnelInput {
 	return sshTunnel
 }
 
+func sshTunnelPublicKeyWarning(name, publicKey string) diag.Diagnostic {
+	detail := fmt.Sprintf(
+		"Resource %q is successfully created but is pending for validation!\n"+
+			"Paste the following public key on your host:\n"+
+			publicKey+"\n"+
+			"Meroxa will try to connect to the resource for 60 minutes and send an email confirmation after a "+
+			"successful resource validation.", name)
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Validate SSH Tunnel",
+		Detail:   detail,
+	}
+}
+
+func sshTunnelRotatedKeyWarning(name, publicKey string) diag.Diagnostic {
+	detail := fmt.Sprintf(
+		"The SSH tunnel key pair of resource %q is rotated!\n"+
+			"Replace the public key on your host with the following one:\n"+
+			publicKey+"\n"+
+			"Meroxa cannot connect to the resource until the host accepts the new key.", name)
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Update SSH Tunnel Key",
+		Detail:   detail,
+	}
+}
+
 func flattenSSHTunnel(sshTunnel *meroxa.ResourceSSHTunnel) map[string]interface{} {
 	if sshTunnel == nil {
 		return nil
@@ -421,20 +622,28 @@ func validateURL() schema.SchemaValidateDiagFunc {
 		// ensure schema
 		if len(s) == 1 {
 			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "URL missing Schema",
-				Detail:   "Please add correct URL Schema",
+				Severity:      diag.Error,
+				Summary:       "URL missing Schema",
+				Detail:        "Please add correct URL Schema",
+				AttributePath: path,
 			})
 			return diags
 		}
 		rest := strings.Split(s[1], "@")
 		if len(rest) == 2 {
 			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "URL includes credentials",
-				Detail:   "The apply will fail if username and password are also set",
+				Severity:      diag.Warning,
+				Summary:       "URL includes credentials",
+				Detail:        "The apply will fail if username and password are also set",
+				AttributePath: path,
 			})
 		}
+
+		// The resource type is not known here, the scheme tells it unless
+		// several types share it. The type itself is checked at plan time.
+		if resourceType, ok := resourceTypeForScheme(strings.TrimSuffix(s[0], "://")); ok {
+			diags = append(diags, lintResourceURL(resourceType, urlStr, path)...)
+		}
 		return diags
 	}
 }
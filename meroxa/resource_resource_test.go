@@ -6,13 +6,17 @@ import (
 	"encoding/pem"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
+	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/meroxa/meroxa-go/pkg/meroxa"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/joeshaw/envdecode"
@@ -35,6 +39,12 @@ var (
 )
 
 func init() {
+	// Only acceptance tests talk to a live account, the offline tests run
+	// against the fake API.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		return
+	}
+
 	err := envdecode.Decode(&Config)
 	if err != nil {
 		log.Fatal(err)
@@ -74,6 +84,13 @@ func TestAccMeroxaResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("meroxa_resource.basic", "status", "ready"),
 				),
 			},
+			{
+				ResourceName:            "meroxa_resource.basic",
+				ImportState:             true,
+				ImportStateId:           "resource-basic",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
 		},
 	})
 }
@@ -105,7 +122,46 @@ func TestAccMeroxaResource_inline(t *testing.T) {
 	})
 }
 
+func TestAccMeroxaResource_revalidate(t *testing.T) {
+	testAccMeroxaResourceRevalidate := func(trigger string) string {
+		return fmt.Sprintf(`
+		resource "meroxa_resource" "revalidate" {
+		  name = "resource-revalidate"
+		  type = "postgres"
+		  url = "%s"
+		  revalidate_trigger = %q
+		}
+		`, Config.PostgresURL, trigger)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMeroxaResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeroxaResourceRevalidate("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeroxaResourceExists("meroxa_resource.revalidate"),
+					resource.TestCheckResourceAttr("meroxa_resource.revalidate", "status", "ready"),
+					resource.TestCheckResourceAttrSet("meroxa_resource.revalidate", "status_last_updated_at"),
+				),
+			},
+			{
+				Config: testAccMeroxaResourceRevalidate("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("meroxa_resource.revalidate", "revalidate_trigger", "2"),
+					resource.TestCheckResourceAttr("meroxa_resource.revalidate", "status", "ready"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMeroxaResource_sshTunnel(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
 	bastionAddr := fmt.Sprintf("%s@%s:22", Config.BastionUser, Config.BastionHost)
 	privatePostgresURL, err := URLWithoutCredentials(Config.PrivatePostgresURL)
 	if err != nil {
@@ -217,3 +273,241 @@ func URLWithoutCredentials(u string) (string, error) {
 func withSSHURL(addr string) string {
 	return fmt.Sprintf("ssh://%s", addr)
 }
+
+func TestMeroxaResource_lifecycle(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	ctx := context.Background()
+	r := resourceResource()
+
+	api.script("resources", "pg", "pending", "starting", "ready")
+	d := testResourceData(t, r, map[string]interface{}{
+		"name":     "pg",
+		"type":     "postgres",
+		"url":      "postgres://pg.example.com:5432/db",
+		"metadata": map[string]interface{}{"team": "data"},
+	})
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	if got := d.Get("status"); got != "ready" {
+		t.Errorf("got status %q, want ready", got)
+	}
+	if got := d.Get("metadata.team"); got != "data" {
+		t.Errorf("got metadata.team %q, want data", got)
+	}
+
+	// Import by name resolves the numeric ID
+	imported := testResourceData(t, r, nil)
+	imported.SetId("pg")
+	if _, err := r.Importer.StateContext(ctx, imported, meta); err != nil {
+		t.Fatal(err)
+	}
+	if imported.Id() != d.Id() {
+		t.Errorf("got imported ID %q, want %q", imported.Id(), d.Id())
+	}
+
+	requireNoError(t, r.DeleteContext(ctx, d, meta))
+	if n := len(api.resources); n != 0 {
+		t.Errorf("got %d resources left, want 0", n)
+	}
+}
+
+func TestMeroxaResource_defaultMetadata(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.metaWith(map[string]interface{}{
+		"default_metadata": []interface{}{
+			map[string]interface{}{"metadata": map[string]interface{}{"owner": "platform", "team": "core"}},
+		},
+	})
+	ctx := context.Background()
+	r := resourceResource()
+
+	d := testResourceData(t, r, map[string]interface{}{
+		"name":     "pg",
+		"type":     "postgres",
+		"url":      "postgres://pg.example.com:5432/db",
+		"metadata": map[string]interface{}{"team": "data"},
+	})
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	want := map[string]interface{}{"owner": "platform", "team": "data"}
+	if got := api.findResource("pg").Metadata; !reflect.DeepEqual(got, want) {
+		t.Errorf("got API metadata %v, want %v", got, want)
+	}
+	if got := d.Get("metadata_all").(map[string]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got metadata_all %v, want %v", got, want)
+	}
+
+	// Inherited keys stay out of metadata so they do not show in the plan
+	if got := d.Get("metadata").(map[string]interface{}); !reflect.DeepEqual(got, map[string]interface{}{"team": "data"}) {
+		t.Errorf("got metadata %v, want only the configured keys", got)
+	}
+}
+
+func TestMeroxaResource_createError(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	r := resourceResource()
+
+	api.script("resources", "pg", "pending", "error")
+	d := testResourceData(t, r, map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"url":  "postgres://pg.example.com:5432/db",
+	})
+
+	if diags := r.CreateContext(context.Background(), d, meta); !diags.HasError() {
+		t.Fatal("expected an error for a resource ending in the error state")
+	}
+}
+
+func TestMeroxaResource_deletedOutsideTerraform(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	ctx := context.Background()
+	r := resourceResource()
+
+	d := testResourceData(t, r, map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"url":  "postgres://pg.example.com:5432/db",
+	})
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	api.mu.Lock()
+	api.resources = make(map[int]*meroxa.Resource)
+	api.mu.Unlock()
+
+	diags := r.ReadContext(ctx, d, meta)
+	requireNoError(t, diags)
+	if d.Id() != "" {
+		t.Errorf("got ID %q, want the resource removed from state", d.Id())
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("got %v, want a single warning", diags)
+	}
+
+	// Deleting what is already gone succeeds
+	d.SetId("1")
+	requireNoError(t, r.DeleteContext(ctx, d, meta))
+}
+
+func TestMeroxaResource_revalidate(t *testing.T) {
+	tests := []struct {
+		desc string
+		// from is the state before validation, states the ones polled after
+		from        meroxa.ResourceState
+		states      []string
+		wantWarning bool
+	}{
+		{desc: "error fixed", from: meroxa.ResourceStateError, states: []string{"error", "pending", "ready"}},
+		{desc: "ready failing", from: meroxa.ResourceStateReady, states: []string{"ready", "starting", "error"}, wantWarning: true},
+		{desc: "error again", from: meroxa.ResourceStateError, states: []string{"error", "pending", "error"}, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			api := newFakeAPI(t)
+			meta := api.meta()
+			ctx := context.Background()
+			r := resourceResource()
+
+			raw := map[string]interface{}{
+				"name":               "pg",
+				"type":               "postgres",
+				"url":                "postgres://pg.example.com:5432/db",
+				"revalidate_trigger": "1",
+			}
+			d := testResourceData(t, r, raw)
+			requireNoError(t, r.CreateContext(ctx, d, meta))
+			api.mu.Lock()
+			api.findResource("pg").Status.State = tt.from
+			api.mu.Unlock()
+			requireNoError(t, r.ReadContext(ctx, d, meta))
+			state := d.State()
+
+			// The first polls still show the state before validation
+			api.script("resources", "pg", tt.states...)
+			raw["revalidate_trigger"] = "2"
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			newState, diags := r.Apply(ctx, state, diff, meta)
+			requireNoError(t, diags)
+
+			if got, want := newState.Attributes["status"], tt.states[len(tt.states)-1]; got != want {
+				t.Errorf("got status %q, want %q", got, want)
+			}
+			if got := len(diags) == 1 && diags[0].Summary == "Resource validation failed"; got != tt.wantWarning {
+				t.Errorf("got %v, want a validation warning %t", diags, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestMeroxaResource_rotateKey(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	ctx := context.Background()
+	r := resourceResource()
+
+	raw := map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"url":  "postgres://pg.example.com:5432/db",
+		"ssh_tunnel": []interface{}{
+			map[string]interface{}{"address": "ssh://user@bastion.example.com:22", "rotate_key_trigger": "1"},
+		},
+	}
+	d := testResourceData(t, r, raw)
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+	state, oldKey := d.State(), d.Get("ssh_tunnel_public_key")
+
+	raw["ssh_tunnel"] = []interface{}{
+		map[string]interface{}{"address": "ssh://user@bastion.example.com:22", "rotate_key_trigger": "2"},
+	}
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := diff.Attributes["ssh_tunnel_public_key"]; a == nil || !a.NewComputed {
+		t.Fatalf("got ssh_tunnel_public_key diff %v, want it known after apply", a)
+	}
+
+	newState, diags := r.Apply(ctx, state, diff, meta)
+	requireNoError(t, diags)
+	newKey := newState.Attributes["ssh_tunnel_public_key"]
+	if newKey == oldKey || newKey != api.findResource("pg").SSHTunnel.PublicKey {
+		t.Errorf("got ssh_tunnel_public_key %q, want the rotated key of the platform", newKey)
+	}
+	if got := newState.Attributes["ssh_tunnel.0.public_key"]; got != newKey {
+		t.Errorf("got public_key %q, want %q", got, newKey)
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "is rotated") || !strings.Contains(diags[0].Detail, newKey) {
+		t.Errorf("got %v, want a single rotation warning with the new key", diags)
+	}
+}
+
+func TestMeroxaResource_retriesTransientFailures(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	ctx := context.Background()
+	r := resourceResource()
+
+	d := testResourceData(t, r, map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"url":  "postgres://pg.example.com:5432/db",
+	})
+	requireNoError(t, r.CreateContext(ctx, d, meta))
+
+	path := "/v1/resources/" + d.Id()
+	api.fail(http.MethodGet, path, http.StatusBadGateway, http.StatusTooManyRequests)
+	before := api.count(http.MethodGet, path)
+
+	requireNoError(t, r.ReadContext(ctx, d, meta))
+	if n := api.count(http.MethodGet, path) - before; n != 3 {
+		t.Errorf("got %d requests, want 3", n)
+	}
+}
@@ -0,0 +1,86 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+// customizeDiffResourceType checks the resource type is supported by the
+// platform, so a typo fails the plan instead of the apply.
+func customizeDiffResourceType(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// The type forces a new resource, existing resources were checked already
+	if !d.NewValueKnown("type") || (d.Id() != "" && !d.HasChange("type")) {
+		return nil
+	}
+
+	resourceType := d.Get("type").(string)
+	supported, listed := supportedResourceTypes(ctx, m.(meroxa.Client))
+	if stringInSlice(resourceType, supported) {
+		return nil
+	}
+
+	source := "Supported types"
+	if !listed {
+		source = "The platform could not list its types, types known to the provider"
+	}
+	if suggestion, ok := closestString(resourceType, supported); ok {
+		return fmt.Errorf("resource type %q is not supported, did you mean %q? %s: %s",
+			resourceType, suggestion, source, strings.Join(supported, ", "))
+	}
+	return fmt.Errorf("resource type %q is not supported. %s: %s", resourceType, source, strings.Join(supported, ", "))
+}
+
+// closestString returns the candidate closest to s, if it is close enough to
+// be a typo.
+func closestString(s string, candidates []string) (string, bool) {
+	best, bestDistance := "", -1
+	for _, c := range candidates {
+		if d := levenshtein(strings.ToLower(s), strings.ToLower(c)); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+
+	// Allow about a typo every three characters, at least two
+	maxDistance := len(s) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	return best, bestDistance >= 0 && bestDistance <= maxDistance
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+func minInt(v ...int) int {
+	m := v[0]
+	for _, n := range v[1:] {
+		if n < m {
+			m = n
+		}
+	}
+	return m
+}
@@ -0,0 +1,99 @@
+package meroxa
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestClosestString(t *testing.T) {
+	types := []string{"postgres", "mysql", "s3", "snowflake"}
+
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{in: "postgress", want: "postgres", wantOK: true},
+		{in: "Postgres", want: "postgres", wantOK: true},
+		{in: "mysq", want: "mysql", wantOK: true},
+		{in: "snowflak", want: "snowflake", wantOK: true},
+		{in: "kafka", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := closestString(tt.in, types)
+		if ok != tt.wantOK || (ok && got != tt.want) {
+			t.Errorf("closestString(%q) = %q, %t, want %q, %t", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestCustomizeDiffResourceType(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	r := resourceResource()
+
+	diff := func(resourceType string) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "pg",
+			"type": resourceType,
+			"url":  "postgres://pg.example.com:5432/db",
+		}), meta)
+		return err
+	}
+
+	err := diff("postgress")
+	if err == nil || !strings.Contains(err.Error(), `did you mean "postgres"?`) {
+		t.Errorf("got %v, want a suggestion for postgres", err)
+	}
+	if err := diff("postgres"); err != nil {
+		t.Errorf("got %v, want no error for a supported type", err)
+	}
+
+	// The supported types are listed once per provider instance
+	if n := api.count(http.MethodGet, "/v1/resource-types"); n != 1 {
+		t.Errorf("got %d resource types requests, want 1", n)
+	}
+}
+
+func TestCustomizeDiffResourceType_offline(t *testing.T) {
+	api := newFakeAPI(t)
+	meta := api.meta()
+	// Planning a new resource runs CustomizeDiff twice, the first two plans fail
+	for i := 0; i < 4; i++ {
+		api.fail(http.MethodGet, "/v1/resource-types", http.StatusNotFound)
+	}
+
+	diff := func(resourceType string) error {
+		_, err := resourceResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "warehouse",
+			"type": resourceType,
+			"url":  resourceType + "://warehouse.example.com:5439/db",
+		}), meta)
+		return err
+	}
+
+	// redshift is not listed by the fake API but is known to the client
+	if err := diff("redshift"); err != nil {
+		t.Errorf("got %v, want the client resource types used as a fallback", err)
+	}
+	if err := diff("redshfit"); err == nil || !strings.Contains(err.Error(), "could not list its types") {
+		t.Errorf("got %v, want the fallback mentioned", err)
+	}
+
+	// The fallback is not cached, the types are listed again once the API is back
+	if err := diff("redshift"); err == nil {
+		t.Error("got no error, want the types listed by the API used")
+	}
+	// Listed types are cached
+	if err := diff("postgres"); err != nil {
+		t.Errorf("got %v, want no error for a listed type", err)
+	}
+	if n := api.count(http.MethodGet, "/v1/resource-types"); n != 5 {
+		t.Errorf("got %d resource types requests, want 5", n)
+	}
+}
@@ -0,0 +1,197 @@
+package meroxa
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMaxBackoff = 30 * time.Second
+	// minBackoff is the wait before the first retry, doubled on every attempt.
+	minBackoff = time.Second
+)
+
+// retryTransport retries idempotent requests failing with a transient error,
+// waiting with an exponential backoff and jitter between attempts.
+type retryTransport struct {
+	next http.RoundTripper
+
+	maxRetries int
+	maxBackoff time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxBackoff time.Duration) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		maxBackoff: maxBackoff,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+
+		reason := retryReason(resp, err)
+		if reason == "" || attempt >= t.maxRetries || !isIdempotent(req) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		// Requests whose body cannot be sent again are not retried
+		retryReq := req.Clone(ctx)
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bErr := req.GetBody()
+			if bErr != nil {
+				return resp, err
+			}
+			retryReq.Body = body
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.Warn(ctx, "Retrying Meroxa API request", map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+			"attempt": attempt + 1,
+			"reason":  reason,
+			"wait":    wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req = retryReq
+	}
+}
+
+// backoff returns the wait before the next attempt. Retry-After sent by the
+// API wins over the computed backoff, up to the max backoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.maxBackoff {
+				return t.maxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := t.maxBackoff
+	if attempt < 32 && minBackoff<<attempt < t.maxBackoff {
+		wait = minBackoff << attempt
+	}
+
+	// Equal jitter, keeps at least half of the backoff between attempts
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec
+}
+
+// retryReason returns why the attempt should be retried, or an empty string
+// if it should not.
+func retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return resp.Status
+	}
+	return ""
+}
+
+// isIdempotent reports whether the request can safely be sent again.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses a Retry-After header, given either in seconds or as a date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// timeoutTransport bounds every request it sends. Installed below the retry
+// transport, each attempt gets a fresh budget.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	defer cancel()
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// The timeout covers reading the body as well, which has to happen before
+	// the context is canceled
+	if err := bufferBody(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// bufferBody reads the response body into memory and closes it. The client
+// never closes response bodies, so transports holding resources for the
+// duration of a request release them once the body is buffered.
+func bufferBody(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return nil
+}
@@ -0,0 +1,127 @@
+package meroxa
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		failures     int
+		wantStatus   int
+		wantAttempts int
+	}{
+		{name: "get recovers", method: http.MethodGet, failures: 2, wantStatus: http.StatusOK, wantAttempts: 3},
+		{name: "get gives up", method: http.MethodGet, failures: 5, wantStatus: http.StatusServiceUnavailable, wantAttempts: 4},
+		{name: "put body is sent again", method: http.MethodPut, failures: 1, wantStatus: http.StatusOK, wantAttempts: 2},
+		{name: "post is not retried", method: http.MethodPost, failures: 1, wantStatus: http.StatusServiceUnavailable, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if body, _ := io.ReadAll(r.Body); string(body) != "body" {
+					t.Errorf("attempt %d: unexpected body %q", attempts, body)
+				}
+				if attempts <= tt.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			c := &http.Client{Transport: newRetryTransport(nil, defaultMaxRetries, defaultMaxBackoff)}
+			req, err := http.NewRequest(tt.method, srv.URL, strings.NewReader("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if wait, ok := retryAfter("7"); !ok || wait != 7*time.Second {
+		t.Errorf("got %s, %t, want 7s, true", wait, ok)
+	}
+	if _, ok := retryAfter("soon"); ok {
+		t.Error("expected an invalid Retry-After to be ignored")
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := retryAfter(date); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("got %s, %t, want up to 1m, true", wait, ok)
+	}
+}
+
+func TestRetryTransport_capsRetryAfter(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: newRetryTransport(nil, defaultMaxRetries, 10*time.Millisecond)}
+
+	start := time.Now()
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retry waited %s, want at most the 10ms max backoff", elapsed)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestTimeoutTransport_bodyOutlivesRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: &timeoutTransport{next: http.DefaultTransport, timeout: 50 * time.Millisecond}}
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The request context is released when RoundTrip returns, the body was
+	// read before and stays available without being closed by the caller
+	time.Sleep(100 * time.Millisecond)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "body" {
+		t.Errorf("got %q, %v, want the buffered body", body, err)
+	}
+}
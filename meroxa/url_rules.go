@@ -0,0 +1,219 @@
+package meroxa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+// resourceURLRule describes the URLs the platform accepts for a resource type.
+type resourceURLRule struct {
+	// schemes are the accepted URL schemes, the first one is the default.
+	schemes []string
+	// path names the path segments the URL must have, e.g. the database.
+	path []string
+	// params maps known query parameters to their valid values, any value is
+	// valid when there are none.
+	params map[string][]string
+}
+
+var postgresSSLModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// resourceURLRules are the URL rules by resource type. Types missing from it
+// are not linted.
+var resourceURLRules = map[meroxa.ResourceType]resourceURLRule{
+	meroxa.ResourceTypePostgres: {
+		schemes: []string{"postgres", "postgresql"},
+		path:    []string{"database"},
+		params:  map[string][]string{"sslmode": postgresSSLModes},
+	},
+	meroxa.ResourceTypeMysql: {
+		schemes: []string{"mysql"},
+		path:    []string{"database"},
+	},
+	meroxa.ResourceTypeRedshift: {
+		schemes: []string{"redshift"},
+		path:    []string{"database"},
+		params:  map[string][]string{"sslmode": postgresSSLModes},
+	},
+	meroxa.ResourceTypeUrl: {
+		schemes: []string{"https", "http"},
+	},
+	meroxa.ResourceTypeS3: {
+		schemes: []string{"s3"},
+		path:    []string{"bucket"},
+	},
+	meroxa.ResourceTypeMongodb: {
+		schemes: []string{"mongodb", "mongodb+srv"},
+		params: map[string][]string{
+			"ssl": {"true", "false"},
+			"tls": {"true", "false"},
+		},
+	},
+	meroxa.ResourceTypeElasticsearch: {
+		schemes: []string{"https", "http"},
+	},
+	meroxa.ResourceTypeSnowflake: {
+		schemes: []string{"snowflakedb"},
+		path:    []string{"database", "schema"},
+	},
+	meroxa.ResourceTypeBigquery: {
+		schemes: []string{"bigquery"},
+	},
+	meroxa.ResourceTypeSqlserver: {
+		schemes: []string{"sqlserver"},
+	},
+	meroxa.ResourceTypeCosmosdb: {
+		schemes: []string{"cosmosdb"},
+	},
+}
+
+// resourceTypeForScheme returns the only resource type accepting scheme.
+func resourceTypeForScheme(scheme string) (meroxa.ResourceType, bool) {
+	var found []meroxa.ResourceType
+	for t, rule := range resourceURLRules {
+		for _, s := range rule.schemes {
+			if s == scheme {
+				found = append(found, t)
+			}
+		}
+	}
+	if len(found) != 1 {
+		return "", false
+	}
+	return found[0], true
+}
+
+// lintResourceURL checks rawURL against the rules of resourceType, the
+// platform rejects URLs with a wrong scheme, port, path or parameter value.
+func lintResourceURL(resourceType meroxa.ResourceType, rawURL string, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule, ok := resourceURLRules[resourceType]
+	if !ok {
+		return diags
+	}
+
+	// Credentials are left out, the client escapes them itself
+	scheme, rest := splitURLSchema(rawURL)
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		rest = rest[i+1:]
+	}
+	u, err := url.Parse(scheme + rest)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid URL",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	if !stringInSlice(u.Scheme, rule.schemes) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "URL scheme does not match the resource type",
+			Detail: fmt.Sprintf("%s resources expect a URL starting with %s, got %q",
+				resourceType, strings.Join(quoteSchemes(rule.schemes), " or "), u.Scheme+"://"),
+			AttributePath: path,
+		})
+	}
+
+	if port := u.Port(); port != "" {
+		if p, pErr := strconv.Atoi(port); pErr != nil || p < 1 || p > 65535 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid URL port",
+				Detail:        fmt.Sprintf("port %q should be a number between 1 and 65535", port),
+				AttributePath: path,
+			})
+		}
+	}
+
+	segments := nonEmpty(strings.Split(strings.Trim(u.Path, "/"), "/")...)
+	if len(segments) < len(rule.path) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "URL path incomplete",
+			Detail: fmt.Sprintf("%s resource URLs should have the path /%s",
+				resourceType, strings.Join(rule.path, "/")),
+			AttributePath: path,
+		})
+	}
+
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		valid, known := rule.params[k]
+		if !known || len(valid) == 0 || stringInSlice(query.Get(k), valid) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Invalid URL parameter %s", k),
+			Detail: fmt.Sprintf("%s %q should be one of: %s",
+				k, query.Get(k), strings.Join(valid, ", ")),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// customizeDiffURL lints the url, configured or built from connection_details,
+// against the declared resource type.
+func customizeDiffURL(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("connection_details") {
+		return nil
+	}
+
+	resourceType := meroxa.ResourceType(d.Get("type").(string))
+	attr, rawURL := "url", d.Get("url").(string)
+	if l := d.Get("connection_details").([]interface{}); len(l) > 0 && l[0] != nil {
+		attr, rawURL = "connection_details", buildResourceURL(resourceType, l[0].(map[string]interface{}))
+	} else if !d.NewValueKnown("url") || (!d.HasChange("url") && !d.HasChange("type")) {
+		// URLs read back from the platform are not linted again
+		return nil
+	}
+
+	// CustomizeDiff returns a single error, a path error keeps the attribute
+	// path in the diagnostic
+	path := cty.GetAttrPath(attr)
+	var errs []string
+	for _, diagnostic := range lintResourceURL(resourceType, rawURL, path) {
+		errs = append(errs, fmt.Sprintf("%s: %s", diagnostic.Summary, diagnostic.Detail))
+	}
+	if len(errs) > 0 {
+		return path.NewErrorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func quoteSchemes(schemes []string) []string {
+	quoted := make([]string, len(schemes))
+	for i, s := range schemes {
+		quoted[i] = fmt.Sprintf("%q", s+"://")
+	}
+	return quoted
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package meroxa
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/meroxa/meroxa-go/pkg/meroxa"
+)
+
+func TestLintResourceURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType meroxa.ResourceType
+		url          string
+		wantSummary  string
+	}{
+		{name: "valid postgres", resourceType: meroxa.ResourceTypePostgres, url: "postgres://u:p@ss@pg.example.com:5432/db?sslmode=require"},
+		{name: "valid mongodb srv", resourceType: meroxa.ResourceTypeMongodb, url: "mongodb+srv://cluster0.example.net/?tls=true"},
+		{name: "valid s3", resourceType: meroxa.ResourceTypeS3, url: "s3://key:secret@us-east-1/bucket"},
+		{
+			name:         "scheme mismatch",
+			resourceType: meroxa.ResourceTypeS3,
+			url:          "postgres://pg.example.com:5432/db",
+			wantSummary:  "URL scheme does not match the resource type",
+		},
+		{
+			name:         "missing database",
+			resourceType: meroxa.ResourceTypePostgres,
+			url:          "postgres://pg.example.com:5432",
+			wantSummary:  "URL path incomplete",
+		},
+		{
+			name:         "invalid port",
+			resourceType: meroxa.ResourceTypeMysql,
+			url:          "mysql://mysql.example.com:70000/db",
+			wantSummary:  "Invalid URL port",
+		},
+		{
+			name:         "invalid sslmode",
+			resourceType: meroxa.ResourceTypePostgres,
+			url:          "postgres://pg.example.com/db?sslmode=required",
+			wantSummary:  "Invalid URL parameter sslmode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := cty.GetAttrPath("url")
+			diags := lintResourceURL(tt.resourceType, tt.url, path)
+
+			if tt.wantSummary == "" {
+				if len(diags) > 0 {
+					t.Errorf("got %v, want no diagnostics", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Summary != tt.wantSummary || diags[0].Severity != diag.Error {
+				t.Fatalf("got %v, want a single %q", diags, tt.wantSummary)
+			}
+			if !diags[0].AttributePath.Equals(path) {
+				t.Errorf("got attribute path %#v, want %#v", diags[0].AttributePath, path)
+			}
+		})
+	}
+}
+
+func TestValidateURL_lintsByScheme(t *testing.T) {
+	validate := func(url string) diag.Diagnostics {
+		return resourceResource().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "pg",
+			"type": "postgres",
+			"url":  url,
+		}))
+	}
+
+	if diags := validate("postgres://pg.example.com:70000/db"); !diags.HasError() {
+		t.Error("expected terraform validate to catch the invalid port")
+	}
+	if diags := validate("postgres://pg.example.com:5432/db?sslmode=maybe"); !diags.HasError() {
+		t.Error("expected terraform validate to catch the invalid sslmode")
+	}
+}
+
+func TestCustomizeDiffURL_typeMismatch(t *testing.T) {
+	api := newFakeAPI(t)
+
+	_, err := resourceResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "orders",
+		"type": "s3",
+		"url":  "postgres://pg.example.com:5432/db",
+	}), api.meta())
+	var pathErr cty.PathError
+	if !errors.As(err, &pathErr) || !pathErr.Path.Equals(cty.GetAttrPath("url")) ||
+		!strings.Contains(err.Error(), "URL scheme does not match the resource type") {
+		t.Errorf("got %v, want a scheme mismatch error on url", err)
+	}
+}
+
+func TestCustomizeDiffURL_connectionDetails(t *testing.T) {
+	api := newFakeAPI(t)
+
+	_, err := resourceResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "pg",
+		"type": "postgres",
+		"connection_details": []interface{}{
+			map[string]interface{}{
+				"host":     "pg.example.com",
+				"port":     70000,
+				"database": "db",
+				"params":   map[string]interface{}{"sslmode": "always"},
+			},
+		},
+	}), api.meta())
+	var pathErr cty.PathError
+	if !errors.As(err, &pathErr) || !pathErr.Path.Equals(cty.GetAttrPath("connection_details")) {
+		t.Fatalf("got %v, want an error on connection_details", err)
+	}
+	want := `Invalid URL port: port "70000" should be a number between 1 and 65535; ` +
+		`Invalid URL parameter sslmode: sslmode "always" should be one of: disable, allow, prefer, require, verify-ca, verify-full`
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err, want)
+	}
+}